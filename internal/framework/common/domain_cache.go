@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// NewDomainCache returns a cache of the domains of an account, keyed by domain name.
+func NewDomainCache(ttl time.Duration) *ListCache[dnsimple.Domain] {
+	return NewListCache(ttl, func(domain dnsimple.Domain) string {
+		return domain.Name
+	})
+}
+
+// NewEmailForwardCache returns a cache of the email forwards of a domain, keyed by ID.
+func NewEmailForwardCache(ttl time.Duration) *ListCache[dnsimple.EmailForward] {
+	return NewListCache(ttl, func(forward dnsimple.EmailForward) string {
+		return strconv.FormatInt(forward.ID, 10)
+	})
+}
+
+// NewDelegationSignerRecordCache returns a cache of the DS records of a domain, keyed by ID.
+func NewDelegationSignerRecordCache(ttl time.Duration) *ListCache[dnsimple.DelegationSignerRecord] {
+	return NewListCache(ttl, func(record dnsimple.DelegationSignerRecord) string {
+		return strconv.FormatInt(record.ID, 10)
+	})
+}
+
+// DomainCacheScope returns the cache scope of the items that belong to a domain.
+func DomainCacheScope(accountID string, domainIdentifier string) string {
+	return accountID + "/" + domainIdentifier
+}
+
+// ListAllDomains walks every page of the domains listing of the account.
+func ListAllDomains(ctx context.Context, client *dnsimple.Client, accountID string) ([]dnsimple.Domain, error) {
+	var domains []dnsimple.Domain
+
+	options := &dnsimple.DomainListOptions{}
+	// Always use max page size
+	options.PerPage = dnsimple.Int(100)
+	for {
+		response, err := client.Domains.ListDomains(ctx, accountID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		domains = append(domains, response.Data...)
+
+		if response.Pagination.CurrentPage >= response.Pagination.TotalPages {
+			break
+		}
+
+		options.Page = dnsimple.Int(response.Pagination.CurrentPage + 1)
+	}
+
+	return domains, nil
+}
+
+// ListAllEmailForwards walks every page of the email forwards listing of the domain.
+func ListAllEmailForwards(ctx context.Context, client *dnsimple.Client, accountID string, domainIdentifier string) ([]dnsimple.EmailForward, error) {
+	var forwards []dnsimple.EmailForward
+
+	// Always use max page size
+	options := &dnsimple.ListOptions{PerPage: dnsimple.Int(100)}
+	for {
+		response, err := client.Domains.ListEmailForwards(ctx, accountID, domainIdentifier, options)
+		if err != nil {
+			return nil, err
+		}
+
+		forwards = append(forwards, response.Data...)
+
+		if response.Pagination.CurrentPage >= response.Pagination.TotalPages {
+			break
+		}
+
+		options.Page = dnsimple.Int(response.Pagination.CurrentPage + 1)
+	}
+
+	return forwards, nil
+}
+
+// ListAllDelegationSignerRecords walks every page of the DS records listing of the domain.
+func ListAllDelegationSignerRecords(ctx context.Context, client *dnsimple.Client, accountID string, domainIdentifier string) ([]dnsimple.DelegationSignerRecord, error) {
+	var records []dnsimple.DelegationSignerRecord
+
+	// Always use max page size
+	options := &dnsimple.ListOptions{PerPage: dnsimple.Int(100)}
+	for {
+		response, err := client.Domains.ListDelegationSignerRecords(ctx, accountID, domainIdentifier, options)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, response.Data...)
+
+		if response.Pagination.CurrentPage >= response.Pagination.TotalPages {
+			break
+		}
+
+		options.Page = dnsimple.Int(response.Pagination.CurrentPage + 1)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"context"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// ListAllPushes walks every page of the domain pushes listing and returns the
+// pushes pending acceptance by the account.
+func ListAllPushes(ctx context.Context, client *dnsimple.Client, accountID string) ([]dnsimple.DomainPush, error) {
+	var pushes []dnsimple.DomainPush
+
+	options := &dnsimple.ListOptions{PerPage: dnsimple.Int(100)}
+	for {
+		response, err := client.Domains.ListPushes(ctx, accountID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		pushes = append(pushes, response.Data...)
+
+		if response.Pagination == nil || response.Pagination.CurrentPage >= response.Pagination.TotalPages {
+			break
+		}
+
+		options.Page = dnsimple.Int(response.Pagination.CurrentPage + 1)
+	}
+
+	return pushes, nil
+}
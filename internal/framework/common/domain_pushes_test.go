@@ -0,0 +1,29 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+func TestListAllPushes(t *testing.T) {
+	t.Parallel()
+
+	server, client := newFixtureClient(t, map[string]string{
+		"GET /v2/2020/pushes": "listPushes/success.http",
+	})
+
+	pushes, err := common.ListAllPushes(context.Background(), client, "2020")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, pushes, 2) {
+		assert.Equal(t, int64(1), pushes[0].ID)
+		assert.Equal(t, int64(100), pushes[0].DomainID)
+		assert.Equal(t, int64(2), pushes[1].ID)
+		assert.Equal(t, int64(101), pushes[1].DomainID)
+	}
+	assert.Equal(t, "per_page=100", server.requests()[0].Query)
+}
@@ -0,0 +1,120 @@
+package common_test
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+// fixtureRequest is a request received by a fixture server.
+type fixtureRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   string
+}
+
+// fixtureServer answers the requests with the API responses recorded in
+// testdata/<endpoint>/<case>.http, which follow the layout of the fixtures of
+// the DNSimple API clients.
+type fixtureServer struct {
+	mu       sync.Mutex
+	received []fixtureRequest
+}
+
+// newFixtureClient returns a client whose requests are answered with the
+// recorded responses of fixtures, keyed by method and path such as
+// "GET /v2/1010/zones/example.com/records". Requests to other paths fail the
+// test.
+func newFixtureClient(t *testing.T, fixtures map[string]string) (*fixtureServer, *dnsimple.Client) {
+	t.Helper()
+
+	fixtureServer := &fixtureServer{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		fixtureServer.mu.Lock()
+		fixtureServer.received = append(fixtureServer.received, fixtureRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.RawQuery,
+			Body:   string(body),
+		})
+		fixtureServer.mu.Unlock()
+
+		fixture, ok := fixtures[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+
+		writeFixture(t, w, fixture)
+	}))
+	t.Cleanup(server.Close)
+
+	client := dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+
+	return fixtureServer, client
+}
+
+// requests returns the requests received so far.
+func (s *fixtureServer) requests() []fixtureRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]fixtureRequest(nil), s.received...)
+}
+
+// writeFixture writes the recorded response of the fixture.
+func writeFixture(t *testing.T, w http.ResponseWriter, fixture string) {
+	t.Helper()
+
+	file, err := os.Open(filepath.Join("testdata", fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	response, err := http.ReadResponse(bufio.NewReader(file), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	for name, values := range response.Header {
+		// The body is written again, so its framing is left to the server.
+		if name == "Content-Length" || name == "Transfer-Encoding" || name == "Connection" {
+			continue
+		}
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.WriteHeader(response.StatusCode)
+	_, _ = io.Copy(w, response.Body)
+}
+
+// testAccClient returns the client of the acceptance tests, skipping the test
+// unless TF_ACC is set, as the acceptance tests of the resources do.
+func testAccClient(t *testing.T) (*dnsimple.Client, string) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+	}
+
+	client, account := test_utils.LoadDNSimpleTestClient()
+	test_utils.TestAccPreCheck(t)
+
+	return client, account
+}
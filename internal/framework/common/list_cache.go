@@ -0,0 +1,182 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ListCache indexes the items of API listings, so that refreshing many
+// resources of the same kind costs one listing per scope instead of one
+// request per resource. A scope is what a listing covers, e.g. the domains of
+// an account or the email forwards of a domain.
+//
+// Each scope has its own lock, held while the scope is hydrated, so that
+// concurrent reads of a scope wait for a single listing and mutations of the
+// scope are applied after it. Mutations are written through with Upsert and
+// Remove, and a scope is listed again once it is invalidated or older than the
+// TTL.
+type ListCache[T any] struct {
+	mu     sync.Mutex
+	scopes map[string]*listCacheEntry[T]
+	ttl    time.Duration
+	key    func(T) string
+}
+
+type listCacheEntry[T any] struct {
+	mu        sync.RWMutex
+	items     map[string]T
+	hydrated  bool
+	expiresAt time.Time
+}
+
+// NewListCache returns an empty cache indexing items by the given key, whose
+// scopes expire after ttl. A zero ttl keeps the scopes until they are
+// invalidated.
+func NewListCache[T any](ttl time.Duration, key func(T) string) *ListCache[T] {
+	return &ListCache[T]{
+		scopes: map[string]*listCacheEntry[T]{},
+		ttl:    ttl,
+		key:    key,
+	}
+}
+
+// entry returns the entry of the scope, creating it if needed.
+func (c *ListCache[T]) entry(scope string) *listCacheEntry[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.scopes[scope]
+	if !ok {
+		entry = &listCacheEntry[T]{}
+		c.scopes[scope] = entry
+	}
+
+	return entry
+}
+
+// fresh reports whether the entry holds items that have not expired. The
+// caller must hold the entry lock.
+func (c *ListCache[T]) fresh(entry *listCacheEntry[T]) bool {
+	return entry.hydrated && (entry.expiresAt.IsZero() || time.Now().Before(entry.expiresAt))
+}
+
+// Get returns the cached item of the scope with the given key. It reports
+// false when the item is not cached, including when the scope is not.
+func (c *ListCache[T]) Get(scope, key string) (T, bool) {
+	entry := c.entry(scope)
+
+	entry.mu.RLock()
+	defer entry.mu.RUnlock()
+
+	var item T
+	if !c.fresh(entry) {
+		return item, false
+	}
+
+	item, ok := entry.items[key]
+	return item, ok
+}
+
+// List returns the cached items of the scope, in no particular order, and
+// whether the scope is cached.
+func (c *ListCache[T]) List(scope string) ([]T, bool) {
+	entry := c.entry(scope)
+
+	entry.mu.RLock()
+	defer entry.mu.RUnlock()
+
+	if !c.fresh(entry) {
+		return nil, false
+	}
+
+	items := make([]T, 0, len(entry.items))
+	for _, item := range entry.items {
+		items = append(items, item)
+	}
+
+	return items, true
+}
+
+// Set replaces the cached items of the scope.
+func (c *ListCache[T]) Set(scope string, items []T) {
+	entry := c.entry(scope)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	c.set(entry, items)
+}
+
+// set replaces the items of the entry. The caller must hold the entry lock.
+func (c *ListCache[T]) set(entry *listCacheEntry[T], items []T) {
+	entry.items = make(map[string]T, len(items))
+	for _, item := range items {
+		entry.items[c.key(item)] = item
+	}
+	entry.hydrated = true
+	entry.expiresAt = time.Time{}
+	if c.ttl > 0 {
+		entry.expiresAt = time.Now().Add(c.ttl)
+	}
+}
+
+// Hydrate lists the items of the scope into the cache, unless it already holds
+// them. Concurrent calls for the same scope list it once.
+func (c *ListCache[T]) Hydrate(ctx context.Context, scope string, list func(context.Context) ([]T, error)) error {
+	entry := c.entry(scope)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if c.fresh(entry) {
+		return nil
+	}
+
+	items, err := list(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.set(entry, items)
+
+	return nil
+}
+
+// Upsert writes a created or updated item through to the cached scope. Scopes
+// that are not cached are left alone, they get the item when hydrated.
+func (c *ListCache[T]) Upsert(scope string, item T) {
+	entry := c.entry(scope)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if !entry.hydrated {
+		return
+	}
+
+	entry.items[c.key(item)] = item
+}
+
+// Remove deletes an item from the cached scope.
+func (c *ListCache[T]) Remove(scope, key string) {
+	entry := c.entry(scope)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	delete(entry.items, key)
+}
+
+// Invalidate drops the cached items of the scope, so that the next Hydrate
+// lists them again.
+func (c *ListCache[T]) Invalidate(scope string) {
+	entry := c.entry(scope)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	entry.items = nil
+	entry.hydrated = false
+	entry.expiresAt = time.Time{}
+}
@@ -0,0 +1,156 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestListCache(t *testing.T) {
+	t.Parallel()
+
+	cache := common.NewDomainCache(0)
+	list := func(context.Context) ([]dnsimple.Domain, error) {
+		return []dnsimple.Domain{
+			{ID: 1, Name: "example.com"},
+			{ID: 2, Name: "example.org"},
+		}, nil
+	}
+
+	// Nothing is cached before the scope is hydrated.
+	_, ok := cache.Get("1010", "example.com")
+	assert.False(t, ok)
+
+	assert.NoError(t, cache.Hydrate(context.Background(), "1010", list))
+
+	domain, ok := cache.Get("1010", "example.com")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), domain.ID)
+
+	_, ok = cache.Get("1010", "example.net")
+	assert.False(t, ok)
+
+	// Scopes are kept apart.
+	_, ok = cache.Get("2020", "example.com")
+	assert.False(t, ok)
+
+	// Mutations are written through.
+	cache.Upsert("1010", dnsimple.Domain{ID: 3, Name: "example.net"})
+	cache.Remove("1010", "example.com")
+
+	_, ok = cache.Get("1010", "example.com")
+	assert.False(t, ok)
+	domain, ok = cache.Get("1010", "example.net")
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), domain.ID)
+
+	// Mutations of scopes that are not cached are dropped.
+	cache.Upsert("2020", dnsimple.Domain{ID: 4, Name: "example.com"})
+	_, ok = cache.Get("2020", "example.com")
+	assert.False(t, ok)
+
+	cache.Invalidate("1010")
+	_, ok = cache.Get("1010", "example.net")
+	assert.False(t, ok)
+}
+
+func TestListCache_ConcurrentHydrate(t *testing.T) {
+	t.Parallel()
+
+	var listings atomic.Int32
+	cache := common.NewEmailForwardCache(0)
+	list := func(context.Context) ([]dnsimple.EmailForward, error) {
+		listings.Add(1)
+		// Give the other calls time to pile up on the scope.
+		time.Sleep(10 * time.Millisecond)
+		return []dnsimple.EmailForward{{ID: 1, AliasEmail: "hello@example.com"}}, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, cache.Hydrate(context.Background(), "1010/example.com", list))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), listings.Load())
+
+	forward, ok := cache.Get("1010/example.com", "1")
+	assert.True(t, ok)
+	assert.Equal(t, "hello@example.com", forward.AliasEmail)
+}
+
+func TestListCache_HydrateError(t *testing.T) {
+	t.Parallel()
+
+	cache := common.NewDelegationSignerRecordCache(0)
+
+	err := cache.Hydrate(context.Background(), "1010/example.com", func(context.Context) ([]dnsimple.DelegationSignerRecord, error) {
+		return nil, errors.New("listing failed")
+	})
+	assert.EqualError(t, err, "listing failed")
+
+	// A failed listing is not cached, the next call lists again.
+	err = cache.Hydrate(context.Background(), "1010/example.com", func(context.Context) ([]dnsimple.DelegationSignerRecord, error) {
+		return []dnsimple.DelegationSignerRecord{{ID: 1}}, nil
+	})
+	assert.NoError(t, err)
+
+	_, ok := cache.Get("1010/example.com", "1")
+	assert.True(t, ok)
+}
+
+func TestListCache_TTL(t *testing.T) {
+	t.Parallel()
+
+	var listings atomic.Int32
+	cache := common.NewDomainCache(50 * time.Millisecond)
+	list := func(context.Context) ([]dnsimple.Domain, error) {
+		listings.Add(1)
+		return []dnsimple.Domain{{ID: 1, Name: "example.com"}}, nil
+	}
+
+	assert.NoError(t, cache.Hydrate(context.Background(), "1010", list))
+	assert.NoError(t, cache.Hydrate(context.Background(), "1010", list))
+	assert.Equal(t, int32(1), listings.Load())
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, ok := cache.Get("1010", "example.com")
+	assert.False(t, ok)
+
+	assert.NoError(t, cache.Hydrate(context.Background(), "1010", list))
+	assert.Equal(t, int32(2), listings.Load())
+}
+
+func TestListAllDomains(t *testing.T) {
+	t.Parallel()
+
+	server, client := test_utils.NewMockClient(t)
+
+	// More domains than fit in a single page.
+	for i := range 150 {
+		server.AddDomain(fmt.Sprintf("example-%d.com", i))
+	}
+
+	domains, err := common.ListAllDomains(context.Background(), client, test_utils.MockServerAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, domains, 150)
+	assert.Equal(t, "example-0.com", domains[0].Name)
+	assert.Equal(t, "example-149.com", domains[149].Name)
+}
@@ -8,5 +8,16 @@ type DnsimpleProviderConfig struct {
 	Client          *dnsimple.Client
 	AccountID       string
 	Prefetch        bool
-	ZoneRecordCache ZoneRecordCache
+	ZoneRecordCache *ZoneRecordCache
+	// DomainCache, EmailForwardCache and DelegationSignerRecordCache hold the
+	// items listed when Prefetch is enabled.
+	DomainCache                 *ListCache[dnsimple.Domain]
+	EmailForwardCache           *ListCache[dnsimple.EmailForward]
+	DelegationSignerRecordCache *ListCache[dnsimple.DelegationSignerRecord]
+	// ZoneRecordBatcher groups zone record changes into batches. It is nil
+	// when the changes are sent one by one.
+	ZoneRecordBatcher *ZoneRecordBatcher
+	// RateLimiter paces the requests sent through Client. It is nil when
+	// client-side throttling is disabled.
+	RateLimiter *RateLimiter
 }
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter paces API requests with a token bucket. The bucket holds up to
+// burst tokens and is refilled at a constant rate; every request takes one
+// token and waits for the refill when the bucket is empty.
+//
+// A nil RateLimiter never blocks, which is how throttling is disabled.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a rate limiter allowing requestsPerSecond requests
+// per second on average, with bursts of up to burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	burst = max(burst, 1)
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// NewHourlyRateLimiter returns a rate limiter spreading requestsPerHour
+// requests evenly over the hour, as DNSimple enforces its quota per hour.
+func NewHourlyRateLimiter(requestsPerHour int64) *RateLimiter {
+	return NewRateLimiter(float64(requestsPerHour)/time.Hour.Seconds(), 1)
+}
+
+// Wait blocks until a request is allowed, or until the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	// Take the token up front so that concurrent callers queue behind each
+	// other instead of all waking up at the same time.
+	l.tokens--
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// Give the token back, the request is not going to be sent.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,61 @@
+package common_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+func TestRateLimiter_Burst(t *testing.T) {
+	t.Parallel()
+
+	limiter := common.NewRateLimiter(1, 3)
+
+	start := time.Now()
+	for range 3 {
+		assert.NoError(t, limiter.Wait(context.Background()))
+	}
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestRateLimiter_PacesConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	limiter := common.NewRateLimiter(100, 1)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 11 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, limiter.Wait(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	// The first request uses the initial token, the other 10 are spaced 10ms apart.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimiter_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	limiter := common.NewHourlyRateLimiter(1)
+	assert.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+}
+
+func TestRateLimiter_Nil(t *testing.T) {
+	t.Parallel()
+
+	var limiter *common.RateLimiter
+	assert.NoError(t, limiter.Wait(context.Background()))
+}
@@ -0,0 +1,134 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// The client does not wrap the secondary DNS endpoints, so the requests
+// below are sent as is.
+
+// PrimaryServer is a primary name server that DNSimple transfers secondary
+// zones from.
+type PrimaryServer struct {
+	ID                   int64    `json:"id"`
+	AccountID            int64    `json:"account_id"`
+	Name                 string   `json:"name"`
+	IP                   string   `json:"ip"`
+	Port                 int      `json:"port"`
+	LinkedSecondaryZones []string `json:"linked_secondary_zones"`
+	CreatedAt            string   `json:"created_at"`
+	UpdatedAt            string   `json:"updated_at"`
+}
+
+type primaryServerResponse struct {
+	dnsimple.Response
+	Data *PrimaryServer `json:"data"`
+}
+
+type primaryServersResponse struct {
+	dnsimple.Response
+	Data []PrimaryServer `json:"data"`
+}
+
+type primaryServerRequest struct {
+	Name string `json:"name"`
+	IP   string `json:"ip"`
+	Port int    `json:"port"`
+}
+
+type primaryServerLinkRequest struct {
+	Zone string `json:"zone"`
+}
+
+type secondaryZoneRequest struct {
+	Name string `json:"name"`
+}
+
+func primaryServersPath(accountID string) string {
+	return fmt.Sprintf("/v2/%s/secondary_dns/primary_servers", accountID)
+}
+
+// CreateSecondaryZone creates a secondary zone, which DNSimple transfers from
+// the primary servers linked to it.
+func CreateSecondaryZone(ctx context.Context, client *dnsimple.Client, accountID string, zoneName string) (*dnsimple.Zone, error) {
+	response := &dnsimple.ZoneResponse{}
+	path := fmt.Sprintf("/v2/%s/secondary_dns/zones", accountID)
+
+	if _, err := client.Request(ctx, http.MethodPost, path, secondaryZoneRequest{Name: zoneName}, response, nil); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// ListAllPrimaryServers walks every page of the primary servers listing and
+// returns the primary servers of the account.
+func ListAllPrimaryServers(ctx context.Context, client *dnsimple.Client, accountID string) ([]PrimaryServer, error) {
+	var servers []PrimaryServer
+
+	page := 1
+	for {
+		response := &primaryServersResponse{}
+		path := fmt.Sprintf("%s?page=%d&per_page=100", primaryServersPath(accountID), page)
+
+		if _, err := client.Request(ctx, http.MethodGet, path, nil, response, nil); err != nil {
+			return nil, err
+		}
+
+		servers = append(servers, response.Data...)
+
+		if response.Pagination == nil || response.Pagination.CurrentPage >= response.Pagination.TotalPages {
+			break
+		}
+
+		page = response.Pagination.CurrentPage + 1
+	}
+
+	return servers, nil
+}
+
+// CreatePrimaryServer creates a primary server.
+func CreatePrimaryServer(ctx context.Context, client *dnsimple.Client, accountID string, name string, ip string, port int) (*PrimaryServer, error) {
+	response := &primaryServerResponse{}
+	request := primaryServerRequest{Name: name, IP: ip, Port: port}
+
+	if _, err := client.Request(ctx, http.MethodPost, primaryServersPath(accountID), request, response, nil); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeletePrimaryServer deletes a primary server.
+func DeletePrimaryServer(ctx context.Context, client *dnsimple.Client, accountID string, serverID int64) error {
+	path := fmt.Sprintf("%s/%d", primaryServersPath(accountID), serverID)
+
+	_, err := client.Request(ctx, http.MethodDelete, path, nil, nil, nil)
+	return err
+}
+
+// LinkPrimaryServer links a primary server to a secondary zone, so that the
+// zone is transferred from it.
+func LinkPrimaryServer(ctx context.Context, client *dnsimple.Client, accountID string, serverID int64, zoneName string) (*PrimaryServer, error) {
+	return changePrimaryServerLink(ctx, client, accountID, serverID, zoneName, "link")
+}
+
+// UnlinkPrimaryServer unlinks a primary server from a secondary zone.
+func UnlinkPrimaryServer(ctx context.Context, client *dnsimple.Client, accountID string, serverID int64, zoneName string) (*PrimaryServer, error) {
+	return changePrimaryServerLink(ctx, client, accountID, serverID, zoneName, "unlink")
+}
+
+func changePrimaryServerLink(ctx context.Context, client *dnsimple.Client, accountID string, serverID int64, zoneName string, action string) (*PrimaryServer, error) {
+	response := &primaryServerResponse{}
+	path := fmt.Sprintf("%s/%d/%s", primaryServersPath(accountID), serverID, action)
+
+	if _, err := client.Request(ctx, http.MethodPut, path, primaryServerLinkRequest{Zone: zoneName}, response, nil); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,142 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+func TestSecondaryDNS(t *testing.T) {
+	t.Parallel()
+
+	server, client := newFixtureClient(t, map[string]string{
+		"POST /v2/1010/secondary_dns/zones":                 "createSecondaryZone/created.http",
+		"GET /v2/1010/secondary_dns/primary_servers":        "listPrimaryServers/success.http",
+		"POST /v2/1010/secondary_dns/primary_servers":       "createPrimaryServer/created.http",
+		"PUT /v2/1010/secondary_dns/primary_servers/4/link": "linkPrimaryServer/success.http",
+		// The recorded unlink answers for the server linked above.
+		"PUT /v2/1010/secondary_dns/primary_servers/4/unlink": "unlinkPrimaryServer/success.http",
+		// The delete endpoints answer with an empty 204 response.
+		"DELETE /v2/1010/secondary_dns/primary_servers/4": "deleteDomain/success.http",
+	})
+	ctx := context.Background()
+
+	zone, err := common.CreateSecondaryZone(ctx, client, "1010", "secondaryexample.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "secondaryexample.com", zone.Name)
+	assert.True(t, zone.Secondary)
+
+	servers, err := common.ListAllPrimaryServers(ctx, client, "1010")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, servers, 2) {
+		assert.Equal(t, "1.1.1.1", servers[1].IP)
+		assert.Equal(t, 4567, servers[1].Port)
+		assert.Equal(t, []string{"secondaryzone.com"}, servers[1].LinkedSecondaryZones)
+	}
+
+	primary, err := common.CreatePrimaryServer(ctx, client, "1010", "PrimaryProduction", "1.2.3.4", 53)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(4), primary.ID)
+	assert.Empty(t, primary.LinkedSecondaryZones)
+
+	linked, err := common.LinkPrimaryServer(ctx, client, "1010", primary.ID, "secondaryzone.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"secondaryzone.com"}, linked.LinkedSecondaryZones)
+
+	unlinked, err := common.UnlinkPrimaryServer(ctx, client, "1010", primary.ID, "secondaryzone.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, unlinked.LinkedSecondaryZones)
+
+	if err := common.DeletePrimaryServer(ctx, client, "1010", primary.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := server.requests()
+	if assert.Len(t, requests, 6) {
+		assert.JSONEq(t, `{"name":"secondaryexample.com"}`, requests[0].Body)
+		assert.Equal(t, "page=1&per_page=100", requests[1].Query)
+		assert.JSONEq(t, `{"name":"PrimaryProduction","ip":"1.2.3.4","port":53}`, requests[2].Body)
+		assert.JSONEq(t, `{"zone":"secondaryzone.com"}`, requests[3].Body)
+		assert.JSONEq(t, `{"zone":"secondaryzone.com"}`, requests[4].Body)
+	}
+}
+
+func TestCreatePrimaryServer_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	_, client := newFixtureClient(t, map[string]string{
+		"POST /v2/1010/secondary_dns/primary_servers": "validation-error.http",
+	})
+
+	_, err := common.CreatePrimaryServer(context.Background(), client, "1010", "", "", 53)
+
+	var errorResponse *dnsimple.ErrorResponse
+	if assert.True(t, errors.As(err, &errorResponse)) {
+		assert.Equal(t, "Validation failed", errorResponse.Message)
+		assert.NotEmpty(t, errorResponse.AttributeErrors)
+	}
+}
+
+func TestAccSecondaryDNS(t *testing.T) {
+	client, account := testAccClient(t)
+	zoneName := utils.RandomName("com", "secondary")
+	ctx := context.Background()
+
+	zone, err := common.CreateSecondaryZone(ctx, client, account, zoneName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := client.Domains.DeleteDomain(ctx, account, zoneName); err != nil {
+			t.Error(err)
+		}
+	})
+	assert.True(t, zone.Secondary)
+
+	primary, err := common.CreatePrimaryServer(ctx, client, account, zoneName+" primary", "203.0.113.53", 53)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := common.DeletePrimaryServer(ctx, client, account, primary.ID); err != nil {
+			t.Error(err)
+		}
+	})
+
+	linked, err := common.LinkPrimaryServer(ctx, client, account, primary.ID, zoneName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, linked.LinkedSecondaryZones, zoneName)
+
+	servers, err := common.ListAllPrimaryServers(ctx, client, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := slices.IndexFunc(servers, func(server common.PrimaryServer) bool { return server.ID == primary.ID })
+	if assert.GreaterOrEqual(t, index, 0) {
+		assert.Contains(t, servers[index].LinkedSecondaryZones, zoneName)
+	}
+
+	unlinked, err := common.UnlinkPrimaryServer(ctx, client, account, primary.ID, zoneName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, unlinked.LinkedSecondaryZones, zoneName)
+}
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// serviceApplyRequest is the payload of the apply service endpoint.
+type serviceApplyRequest struct {
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+// ApplyService applies a one-click service to a domain with its settings.
+//
+// The client encodes the settings of the request with a URL tag, which the
+// API does not read from a JSON body, so the request is sent as is.
+func ApplyService(ctx context.Context, client *dnsimple.Client, accountID string, serviceIdentifier string, domainName string, settings map[string]string) error {
+	path := fmt.Sprintf("/v2/%s/domains/%s/services/%s", accountID, domainName, serviceIdentifier)
+
+	_, err := client.Request(ctx, http.MethodPost, path, serviceApplyRequest{Settings: settings}, nil, nil)
+	return err
+}
+
+// ListAllServices walks every page of the one-click services listing and
+// returns the services available in DNSimple.
+func ListAllServices(ctx context.Context, client *dnsimple.Client) ([]dnsimple.Service, error) {
+	return listAllServices(func(options *dnsimple.ListOptions) (*dnsimple.ServicesResponse, error) {
+		return client.Services.ListServices(ctx, options)
+	})
+}
+
+// ListAllAppliedServices walks every page of the applied services listing and
+// returns the one-click services applied to a domain.
+func ListAllAppliedServices(ctx context.Context, client *dnsimple.Client, accountID string, domainName string) ([]dnsimple.Service, error) {
+	return listAllServices(func(options *dnsimple.ListOptions) (*dnsimple.ServicesResponse, error) {
+		return client.Services.AppliedServices(ctx, accountID, domainName, options)
+	})
+}
+
+func listAllServices(list func(options *dnsimple.ListOptions) (*dnsimple.ServicesResponse, error)) ([]dnsimple.Service, error) {
+	var services []dnsimple.Service
+
+	options := &dnsimple.ListOptions{PerPage: dnsimple.Int(100)}
+	for {
+		response, err := list(options)
+		if err != nil {
+			return nil, err
+		}
+
+		services = append(services, response.Data...)
+
+		if response.Pagination == nil || response.Pagination.CurrentPage >= response.Pagination.TotalPages {
+			break
+		}
+
+		options.Page = dnsimple.Int(response.Pagination.CurrentPage + 1)
+	}
+
+	return services, nil
+}
@@ -0,0 +1,94 @@
+package common_test
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+func TestListAllServices(t *testing.T) {
+	t.Parallel()
+
+	server, client := newFixtureClient(t, map[string]string{
+		"GET /v2/services": "listServices/success.http",
+	})
+
+	services, err := common.ListAllServices(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, services, 2) {
+		assert.Equal(t, "service1", services[0].SID)
+		assert.False(t, services[0].RequiresSetup)
+		assert.Equal(t, "service2", services[1].SID)
+		if assert.Len(t, services[1].Settings, 1) {
+			assert.Equal(t, "username", services[1].Settings[0].Name)
+		}
+	}
+	assert.Equal(t, "per_page=100", server.requests()[0].Query)
+}
+
+func TestListAllAppliedServices(t *testing.T) {
+	t.Parallel()
+
+	_, client := newFixtureClient(t, map[string]string{
+		"GET /v2/1010/domains/example.com/services": "appliedServices/success.http",
+		"GET /v2/1010/domains/missing.com/services": "notfound-domain.http",
+	})
+	ctx := context.Background()
+
+	services, err := common.ListAllAppliedServices(ctx, client, "1010", "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, services, 1) {
+		assert.Equal(t, "wordpress", services[0].SID)
+	}
+
+	_, err = common.ListAllAppliedServices(ctx, client, "1010", "missing.com")
+	assert.Error(t, err)
+}
+
+func TestApplyService(t *testing.T) {
+	t.Parallel()
+
+	server, client := newFixtureClient(t, map[string]string{
+		"POST /v2/1010/domains/example.com/services/wordpress": "applyService/success.http",
+		"POST /v2/1010/domains/missing.com/services/wordpress": "notfound-domain.http",
+	})
+	ctx := context.Background()
+
+	if err := common.ApplyService(ctx, client, "1010", "wordpress", "example.com", map[string]string{"site": "example"}); err != nil {
+		t.Fatal(err)
+	}
+	// The settings are sent in the JSON body.
+	assert.JSONEq(t, `{"settings":{"site":"example"}}`, server.requests()[0].Body)
+
+	assert.Error(t, common.ApplyService(ctx, client, "1010", "wordpress", "missing.com", map[string]string{"site": "example"}))
+}
+
+func TestAccApplyService(t *testing.T) {
+	client, account := testAccClient(t)
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+	ctx := context.Background()
+
+	if err := common.ApplyService(ctx, client, account, "heroku", domainName, map[string]string{"app": "terraform-provider-dnsimple"}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := client.Services.UnapplyService(ctx, account, "heroku", domainName); err != nil {
+			t.Error(err)
+		}
+	})
+
+	services, err := common.ListAllAppliedServices(ctx, client, account, domainName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, slices.ContainsFunc(services, func(service dnsimple.Service) bool { return service.SID == "heroku" }))
+}
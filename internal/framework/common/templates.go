@@ -0,0 +1,57 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// templateUpdateRequest is the payload of the template update endpoint. The
+// description is always sent so that it can be cleared.
+type templateUpdateRequest struct {
+	SID         string `json:"sid"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// UpdateTemplate updates a template.
+//
+// The client omits an empty description from the update, which leaves the
+// description unchanged, so the request is sent as is.
+func UpdateTemplate(ctx context.Context, client *dnsimple.Client, accountID string, templateID int64, template dnsimple.Template) (*dnsimple.Template, error) {
+	response := &dnsimple.TemplateResponse{}
+	path := fmt.Sprintf("/v2/%s/templates/%d", accountID, templateID)
+	request := templateUpdateRequest{SID: template.SID, Name: template.Name, Description: template.Description}
+
+	if _, err := client.Request(ctx, http.MethodPatch, path, request, response, nil); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// ListAllTemplateRecords walks every page of the template records listing and
+// returns the records of the template.
+func ListAllTemplateRecords(ctx context.Context, client *dnsimple.Client, accountID string, templateIdentifier string) ([]dnsimple.TemplateRecord, error) {
+	var records []dnsimple.TemplateRecord
+
+	options := &dnsimple.ListOptions{PerPage: dnsimple.Int(100)}
+	for {
+		response, err := client.Templates.ListTemplateRecords(ctx, accountID, templateIdentifier, options)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, response.Data...)
+
+		if response.Pagination == nil || response.Pagination.CurrentPage >= response.Pagination.TotalPages {
+			break
+		}
+
+		options.Page = dnsimple.Int(response.Pagination.CurrentPage + 1)
+	}
+
+	return records, nil
+}
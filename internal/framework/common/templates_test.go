@@ -0,0 +1,84 @@
+package common_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+func TestUpdateTemplate(t *testing.T) {
+	t.Parallel()
+
+	server, client := newFixtureClient(t, map[string]string{
+		"PATCH /v2/1010/templates/1": "updateTemplate/success.http",
+		"PATCH /v2/1010/templates/2": "notfound-template.http",
+	})
+	ctx := context.Background()
+
+	template, err := common.UpdateTemplate(ctx, client, "1010", 1, dnsimple.Template{SID: "alpha", Name: "Alpha"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1), template.ID)
+	assert.Equal(t, "alpha", template.SID)
+	assert.Equal(t, "Alpha", template.Name)
+
+	// The empty description is sent, so that it is cleared.
+	assert.JSONEq(t, `{"sid":"alpha","name":"Alpha","description":""}`, server.requests()[0].Body)
+
+	_, err = common.UpdateTemplate(ctx, client, "1010", 2, dnsimple.Template{SID: "beta", Name: "Beta"})
+	assert.Error(t, err)
+}
+
+func TestListAllTemplateRecords(t *testing.T) {
+	t.Parallel()
+
+	server, client := newFixtureClient(t, map[string]string{
+		"GET /v2/1010/templates/alpha/records": "listTemplateRecords/success.http",
+		"GET /v2/1010/templates/beta/records":  "notfound-template.http",
+	})
+	ctx := context.Background()
+
+	records, err := common.ListAllTemplateRecords(ctx, client, "1010", "alpha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, "A", records[0].Type)
+		assert.Equal(t, "192.168.1.1", records[0].Content)
+		assert.Equal(t, "www", records[1].Name)
+		assert.Equal(t, "{{domain}}", records[1].Content)
+	}
+	assert.Equal(t, "per_page=100", server.requests()[0].Query)
+
+	_, err = common.ListAllTemplateRecords(ctx, client, "1010", "beta")
+	assert.Error(t, err)
+}
+
+func TestAccUpdateTemplate(t *testing.T) {
+	client, account := testAccClient(t)
+	sid := strings.TrimSuffix(utils.RandomName("", "template"), ".")
+	ctx := context.Background()
+
+	created, err := client.Templates.CreateTemplate(ctx, account, dnsimple.Template{SID: sid, Name: sid, Description: "Cleared on update"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := client.Templates.DeleteTemplate(ctx, account, sid); err != nil {
+			t.Error(err)
+		}
+	})
+
+	template, err := common.UpdateTemplate(ctx, client, account, created.Data.ID, dnsimple.Template{SID: sid, Name: sid + " updated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, sid+" updated", template.Name)
+	assert.Empty(t, template.Description)
+}
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// ListVanityNameServers returns the vanity name servers of a domain with the
+// given names, which are the NS records at the apex of its zone, with the
+// addresses of their A and AAAA records when the name servers are named
+// after the domain. The list is empty when vanity name servers are disabled.
+//
+// Without names, the name servers named after the domain are returned, as
+// the API has no endpoint to read the names the enable endpoint set up.
+func ListVanityNameServers(ctx context.Context, client *dnsimple.Client, accountID string, domainName string, names []string) ([]dnsimple.VanityNameServer, error) {
+	records, err := ListAllZoneRecords(ctx, client, accountID, domainName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := "." + domainName
+
+	var nameServers []dnsimple.VanityNameServer
+	for _, record := range records {
+		if record.Name != "" || record.Type != "NS" {
+			continue
+		}
+
+		name := strings.TrimSuffix(record.Content, ".")
+		if names != nil && !slices.Contains(names, name) {
+			continue
+		}
+		if names == nil && !strings.HasSuffix(name, suffix) {
+			continue
+		}
+
+		nameServer := dnsimple.VanityNameServer{Name: name}
+
+		// The addresses of white-label name servers are not in the zone.
+		if host, ok := strings.CutSuffix(name, suffix); ok {
+			for _, address := range records {
+				if address.Name != host {
+					continue
+				}
+
+				switch address.Type {
+				case "A":
+					nameServer.IPv4 = address.Content
+				case "AAAA":
+					nameServer.IPv6 = address.Content
+				}
+			}
+		}
+
+		nameServers = append(nameServers, nameServer)
+	}
+
+	slices.SortFunc(nameServers, func(a, b dnsimple.VanityNameServer) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return nameServers, nil
+}
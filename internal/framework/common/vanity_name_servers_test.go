@@ -0,0 +1,41 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+func TestListVanityNameServers(t *testing.T) {
+	t.Parallel()
+
+	_, client := newFixtureClient(t, map[string]string{
+		"GET /v2/1010/zones/example.com/records": "listZoneRecords/success.http",
+		"GET /v2/1010/zones/missing.com/records": "notfound-zone.http",
+	})
+	ctx := context.Background()
+
+	// None of the name servers of the recorded zone is named after it.
+	nameServers, err := common.ListVanityNameServers(ctx, client, "1010", "example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, nameServers)
+
+	// White-label name servers are found by the names they were enabled with,
+	// without addresses as those are not in the zone.
+	nameServers, err = common.ListVanityNameServers(ctx, client, "1010", "example.com", []string{"ns2.dnsimple.com", "ns1.dnsimple.com", "ns1.example.net"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []dnsimple.VanityNameServer{
+		{Name: "ns1.dnsimple.com"},
+		{Name: "ns2.dnsimple.com"},
+	}, nameServers)
+
+	_, err = common.ListVanityNameServers(ctx, client, "1010", "missing.com", nil)
+	assert.Error(t, err)
+}
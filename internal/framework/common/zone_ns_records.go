@@ -0,0 +1,50 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// zoneNsRecordsRequest is the payload of the zone NS records update endpoint.
+type zoneNsRecordsRequest struct {
+	NsNames []string `json:"ns_names"`
+}
+
+// ListZoneNsRecords returns the NS records at the apex of the zone.
+func ListZoneNsRecords(ctx context.Context, client *dnsimple.Client, accountID string, zoneName string) ([]dnsimple.ZoneRecord, error) {
+	records, err := ListAllZoneRecords(ctx, client, accountID, zoneName, &dnsimple.ZoneRecordListOptions{
+		Name: dnsimple.String(""),
+		Type: dnsimple.String("NS"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	apex := make([]dnsimple.ZoneRecord, 0, len(records))
+	for _, record := range records {
+		if record.Name == "" && record.Type == "NS" {
+			apex = append(apex, record)
+		}
+	}
+
+	return apex, nil
+}
+
+// UpdateZoneNsRecords replaces the NS records at the apex of the zone with
+// records for the given name servers, and returns the new records.
+//
+// The client does not wrap the zone NS records update endpoint, so the
+// request is sent as is.
+func UpdateZoneNsRecords(ctx context.Context, client *dnsimple.Client, accountID string, zoneName string, nameServers []string) ([]dnsimple.ZoneRecord, error) {
+	response := &dnsimple.ZoneRecordsResponse{}
+	path := fmt.Sprintf("/v2/%s/zones/%s/ns_records", accountID, zoneName)
+
+	if _, err := client.Request(ctx, http.MethodPut, path, zoneNsRecordsRequest{NsNames: nameServers}, response, nil); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,102 @@
+package common_test
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+func nsRecordsContent(records []dnsimple.ZoneRecord) []string {
+	content := make([]string, 0, len(records))
+	for _, record := range records {
+		content = append(content, strings.TrimSuffix(record.Content, "."))
+	}
+	return content
+}
+
+func TestListZoneNsRecords(t *testing.T) {
+	t.Parallel()
+
+	server, client := newFixtureClient(t, map[string]string{
+		"GET /v2/1010/zones/example.com/records": "listZoneRecords/success.http",
+		"GET /v2/1010/zones/missing.com/records": "notfound-zone.http",
+	})
+	ctx := context.Background()
+
+	records, err := common.ListZoneNsRecords(ctx, client, "1010", "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The SOA record of the listing is left out.
+	assert.Equal(t, []string{"ns1.dnsimple.com", "ns2.dnsimple.com", "ns3.dnsimple.com", "ns4.dnsimple.com"}, nsRecordsContent(records))
+
+	query, err := url.ParseQuery(server.requests()[0].Query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "", query.Get("name"))
+	assert.True(t, query.Has("name"))
+	assert.Equal(t, "NS", query.Get("type"))
+
+	_, err = common.ListZoneNsRecords(ctx, client, "1010", "missing.com")
+	assert.Error(t, err)
+}
+
+func TestUpdateZoneNsRecords(t *testing.T) {
+	t.Parallel()
+
+	server, client := newFixtureClient(t, map[string]string{
+		"PUT /v2/1010/zones/example.com/ns_records": "updateZoneNsRecords/success.http",
+		"PUT /v2/1010/zones/missing.com/ns_records": "notfound-zone.http",
+	})
+	ctx := context.Background()
+
+	nameServers := []string{"ns1.example.com", "ns2.example.com", "ns1.foo.bar", "ns2.foo.bar"}
+	records, err := common.UpdateZoneNsRecords(ctx, client, "1010", "example.com", nameServers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, nameServers, nsRecordsContent(records))
+	assert.JSONEq(t, `{"ns_names":["ns1.example.com","ns2.example.com","ns1.foo.bar","ns2.foo.bar"]}`, server.requests()[0].Body)
+
+	_, err = common.UpdateZoneNsRecords(ctx, client, "1010", "missing.com", nameServers)
+	assert.Error(t, err)
+}
+
+func TestAccUpdateZoneNsRecords(t *testing.T) {
+	client, account := testAccClient(t)
+	zoneName := os.Getenv("DNSIMPLE_DOMAIN")
+	ctx := context.Background()
+
+	records, err := common.ListZoneNsRecords(ctx, client, account, zoneName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	original := nsRecordsContent(records)
+
+	t.Cleanup(func() {
+		if _, err := common.UpdateZoneNsRecords(ctx, client, account, zoneName, original); err != nil {
+			t.Error(err)
+		}
+	})
+
+	nameServers := append(slices.Clone(original), "ns1.example.net")
+	records, err = common.UpdateZoneNsRecords(ctx, client, account, zoneName, nameServers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.ElementsMatch(t, nameServers, nsRecordsContent(records))
+
+	records, err = common.ListZoneNsRecords(ctx, client, account, zoneName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.ElementsMatch(t, nameServers, nsRecordsContent(records))
+}
@@ -0,0 +1,210 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// Kinds of operations of a zone record batch, as named by the API in the
+// request and in its errors.
+const (
+	BatchCreates = "creates"
+	BatchUpdates = "updates"
+	BatchDeletes = "deletes"
+)
+
+// ZoneRecordBatcher gathers the zone record changes made within a short
+// window and sends them per zone through the batch change endpoint, so that
+// the changes of an apply land as a few atomic requests instead of one request
+// per record.
+//
+// Callers block until the batch holding their change is sent, and get the
+// result of their own change back, even once their context is cancelled.
+type ZoneRecordBatcher struct {
+	client *dnsimple.Client
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[zoneRecordBatchKey]*zoneRecordBatch
+}
+
+type zoneRecordBatchKey struct {
+	accountID string
+	zoneName  string
+}
+
+type zoneRecordBatch struct {
+	// ctx is the context of the first change of the batch, without its
+	// cancellation, so that the batch is sent for every caller.
+	ctx     context.Context
+	request dnsimple.BatchChangeZoneRecordsRequest
+	done    chan struct{}
+
+	response *dnsimple.BatchChangeZoneRecordsData
+	err      error
+}
+
+// NewZoneRecordBatcher returns a batcher sending the changes made within
+// window of the first change of a zone as one batch.
+func NewZoneRecordBatcher(client *dnsimple.Client, window time.Duration) *ZoneRecordBatcher {
+	return &ZoneRecordBatcher{
+		client:  client,
+		window:  window,
+		pending: map[zoneRecordBatchKey]*zoneRecordBatch{},
+	}
+}
+
+// CreateRecord creates a record in the next batch of the zone.
+func (b *ZoneRecordBatcher) CreateRecord(ctx context.Context, accountID string, zoneName string, attributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecord, error) {
+	batch, index := b.enqueue(ctx, accountID, zoneName, func(request *dnsimple.BatchChangeZoneRecordsRequest) int {
+		request.Creates = append(request.Creates, attributes)
+		return len(request.Creates) - 1
+	})
+
+	if err := b.wait(batch, BatchCreates, index); err != nil {
+		return nil, err
+	}
+
+	if index >= len(batch.response.Creates) {
+		return nil, fmt.Errorf("batch response is missing created record %d", index)
+	}
+
+	return &batch.response.Creates[index], nil
+}
+
+// UpdateRecord updates a record in the next batch of the zone.
+func (b *ZoneRecordBatcher) UpdateRecord(ctx context.Context, accountID string, zoneName string, recordID int64, attributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecord, error) {
+	update := dnsimple.ZoneRecordUpdateRequest{
+		ID:       recordID,
+		Type:     attributes.Type,
+		Name:     attributes.Name,
+		Content:  attributes.Content,
+		TTL:      attributes.TTL,
+		Priority: attributes.Priority,
+		Regions:  attributes.Regions,
+	}
+
+	batch, index := b.enqueue(ctx, accountID, zoneName, func(request *dnsimple.BatchChangeZoneRecordsRequest) int {
+		request.Updates = append(request.Updates, update)
+		return len(request.Updates) - 1
+	})
+
+	if err := b.wait(batch, BatchUpdates, index); err != nil {
+		return nil, err
+	}
+
+	if index >= len(batch.response.Updates) {
+		return nil, fmt.Errorf("batch response is missing updated record %d", recordID)
+	}
+
+	return &batch.response.Updates[index], nil
+}
+
+// DeleteRecord deletes a record in the next batch of the zone.
+func (b *ZoneRecordBatcher) DeleteRecord(ctx context.Context, accountID string, zoneName string, recordID int64) error {
+	batch, index := b.enqueue(ctx, accountID, zoneName, func(request *dnsimple.BatchChangeZoneRecordsRequest) int {
+		request.Deletes = append(request.Deletes, dnsimple.ZoneRecordDeleteRequest{ID: recordID})
+		return len(request.Deletes) - 1
+	})
+
+	return b.wait(batch, BatchDeletes, index)
+}
+
+// enqueue adds a change to the pending batch of the zone, starting a new batch
+// if there is none, and returns the batch and the index of the change in it.
+func (b *ZoneRecordBatcher) enqueue(ctx context.Context, accountID string, zoneName string, add func(*dnsimple.BatchChangeZoneRecordsRequest) int) (*zoneRecordBatch, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := zoneRecordBatchKey{accountID: accountID, zoneName: zoneName}
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &zoneRecordBatch{
+			ctx:  context.WithoutCancel(ctx),
+			done: make(chan struct{}),
+		}
+		b.pending[key] = batch
+
+		time.AfterFunc(b.window, func() {
+			b.send(key, batch)
+		})
+	}
+
+	return batch, add(&batch.request)
+}
+
+// send sends the batch once it is no longer pending.
+func (b *ZoneRecordBatcher) send(key zoneRecordBatchKey, batch *zoneRecordBatch) {
+	b.mu.Lock()
+	delete(b.pending, key)
+	b.mu.Unlock()
+
+	defer close(batch.done)
+
+	response, err := b.client.Zones.BatchChangeZoneRecords(batch.ctx, key.accountID, key.zoneName, batch.request)
+	if err != nil {
+		batch.err = err
+		return
+	}
+
+	batch.response = response.Data
+	if batch.response == nil {
+		batch.response = &dnsimple.BatchChangeZoneRecordsData{}
+	}
+}
+
+// wait blocks until the batch is sent and returns the error of the change.
+// The change is sent along with the others even when the context of its
+// caller is cancelled, so it waits for the actual result instead of reporting
+// a change that did happen as cancelled.
+func (b *ZoneRecordBatcher) wait(batch *zoneRecordBatch, kind string, index int) error {
+	<-batch.done
+
+	if batch.err != nil {
+		return BatchOperationError(batch.err, kind, index)
+	}
+
+	return nil
+}
+
+// BatchOperationError returns the error of a single operation of a batch
+// rejected by the API. Since batches are atomic, an operation without errors
+// of its own gets an error telling the batch was rejected because of other
+// operations.
+func BatchOperationError(err error, kind string, index int) error {
+	var errorResponse *dnsimple.ErrorResponse
+	if !errors.As(err, &errorResponse) || len(errorResponse.AttributeErrors) == 0 {
+		return err
+	}
+
+	prefix := fmt.Sprintf("%s[%d]", kind, index)
+	operationError := &dnsimple.ErrorResponse{
+		Response:        errorResponse.Response,
+		Message:         errorResponse.Message,
+		AttributeErrors: map[string][]string{},
+	}
+
+	found := false
+	for key, messages := range errorResponse.AttributeErrors {
+		switch {
+		case key == prefix:
+			found = true
+			operationError.Message = strings.Join(messages, ", ")
+		case strings.HasPrefix(key, prefix+"."):
+			found = true
+			operationError.AttributeErrors[strings.TrimPrefix(key, prefix+".")] = messages
+		}
+	}
+
+	if !found {
+		operationError.Message = fmt.Sprintf("%s: the batch of zone record changes was rejected because of other changes in it", errorResponse.Message)
+	}
+
+	return operationError
+}
@@ -0,0 +1,183 @@
+package common_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+// newBatchServer serves the batch change endpoint with the given handler and
+// counts the batches it receives.
+func newBatchServer(t *testing.T, handler func(w http.ResponseWriter, request dnsimple.BatchChangeZoneRecordsRequest)) (*dnsimple.Client, *atomic.Int32) {
+	t.Helper()
+
+	var batches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/1010/zones/example.com/batch" {
+			http.NotFound(w, r)
+			return
+		}
+
+		batches.Add(1)
+
+		var request dnsimple.BatchChangeZoneRecordsRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, request)
+	}))
+	t.Cleanup(server.Close)
+
+	client := dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+
+	return client, &batches
+}
+
+func TestZoneRecordBatcher(t *testing.T) {
+	t.Parallel()
+
+	client, batches := newBatchServer(t, func(w http.ResponseWriter, request dnsimple.BatchChangeZoneRecordsRequest) {
+		data := dnsimple.BatchChangeZoneRecordsData{}
+		for i, create := range request.Creates {
+			data.Creates = append(data.Creates, dnsimple.ZoneRecord{ID: int64(100 + i), Name: *create.Name, Type: create.Type, Content: create.Content})
+		}
+		for _, update := range request.Updates {
+			data.Updates = append(data.Updates, dnsimple.ZoneRecord{ID: update.ID, Name: *update.Name, Type: update.Type, Content: update.Content})
+		}
+		for _, deletion := range request.Deletes {
+			data.Deletes = append(data.Deletes, dnsimple.ZoneRecordDeleteResult{ID: deletion.ID})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	})
+	batcher := common.NewZoneRecordBatcher(client, 50*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"a", "b", "c"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			record, err := batcher.CreateRecord(context.Background(), "1010", "example.com", dnsimple.ZoneRecordAttributes{Name: dnsimple.String(name), Type: "A", Content: "192.0.2.1"})
+			if assert.NoError(t, err) {
+				// Each caller gets its own record back.
+				assert.Equal(t, name, record.Name)
+			}
+		}()
+	}
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+
+		record, err := batcher.UpdateRecord(context.Background(), "1010", "example.com", 7, dnsimple.ZoneRecordAttributes{Name: dnsimple.String("mx"), Type: "MX", Content: "mail.example.com"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(7), record.ID)
+			assert.Equal(t, "mail.example.com", record.Content)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+
+		assert.NoError(t, batcher.DeleteRecord(context.Background(), "1010", "example.com", 8))
+	}()
+	wg.Wait()
+
+	assert.Equal(t, int32(1), batches.Load())
+
+	// Changes made after a batch was sent go in the next one.
+	_, err := batcher.CreateRecord(context.Background(), "1010", "example.com", dnsimple.ZoneRecordAttributes{Name: dnsimple.String("d"), Type: "A", Content: "192.0.2.1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), batches.Load())
+}
+
+func TestZoneRecordBatcher_Error(t *testing.T) {
+	t.Parallel()
+
+	client, batches := newBatchServer(t, func(w http.ResponseWriter, request dnsimple.BatchChangeZoneRecordsRequest) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Validation failed","errors":{"creates":[{"index":1,"message":"Validation failed","errors":{"content":["is not a valid IPv4 address"]}}]}}`))
+	})
+	batcher := common.NewZoneRecordBatcher(client, 50*time.Millisecond)
+
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i, content := range []string{"192.0.2.1", "invalid"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = batcher.CreateRecord(context.Background(), "1010", "example.com", dnsimple.ZoneRecordAttributes{Name: dnsimple.String("www"), Type: "A", Content: content})
+		}()
+	}
+	wg.Wait()
+
+	// Batches are atomic, every change of a rejected batch fails.
+	assert.Equal(t, int32(1), batches.Load())
+	assert.Error(t, errs[0])
+	assert.Error(t, errs[1])
+}
+
+func TestBatchOperationError(t *testing.T) {
+	t.Parallel()
+
+	err := &dnsimple.ErrorResponse{
+		Message: "Validation failed",
+		AttributeErrors: map[string][]string{
+			"creates[1]":         {"Validation failed"},
+			"creates[1].content": {"is not a valid IPv4 address"},
+			"deletes[0]":         {"Record not found"},
+		},
+	}
+
+	var errorResponse *dnsimple.ErrorResponse
+
+	// An operation gets its own errors.
+	if assert.True(t, errors.As(common.BatchOperationError(err, common.BatchCreates, 1), &errorResponse)) {
+		assert.Equal(t, "Validation failed", errorResponse.Message)
+		assert.Equal(t, map[string][]string{"content": {"is not a valid IPv4 address"}}, errorResponse.AttributeErrors)
+	}
+
+	if assert.True(t, errors.As(common.BatchOperationError(err, common.BatchDeletes, 0), &errorResponse)) {
+		assert.Equal(t, "Record not found", errorResponse.Message)
+		assert.Empty(t, errorResponse.AttributeErrors)
+	}
+
+	// An operation without errors was rejected along with the others.
+	if assert.True(t, errors.As(common.BatchOperationError(err, common.BatchCreates, 0), &errorResponse)) {
+		assert.Contains(t, errorResponse.Message, "rejected because of other changes")
+		assert.Empty(t, errorResponse.AttributeErrors)
+	}
+
+	// Other errors are returned as they are.
+	other := errors.New("connection refused")
+	assert.Equal(t, other, common.BatchOperationError(other, common.BatchCreates, 0))
+}
+
+func TestZoneRecordBatcher_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	client, batches := newBatchServer(t, func(w http.ResponseWriter, request dnsimple.BatchChangeZoneRecordsRequest) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": dnsimple.BatchChangeZoneRecordsData{}})
+	})
+	batcher := common.NewZoneRecordBatcher(client, 50*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The change is sent anyway, so the caller gets its actual result.
+	assert.NoError(t, batcher.DeleteRecord(ctx, "1010", "example.com", 1))
+	assert.Equal(t, int32(1), batches.Load())
+}
@@ -1,78 +1,114 @@
 package common
 
 import (
+	"cmp"
 	"context"
-	"sync"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
 )
 
-var zoneRecordCacheMutex = &sync.RWMutex{}
+// ZoneRecordCache holds the records of the zones read with prefetch enabled,
+// so that refreshing many dnsimple_zone_record resources costs one listing
+// per zone instead of one request per record.
+//
+// It is a ListCache of records keyed by ID, scoped by account and zone name.
+type ZoneRecordCache struct {
+	records *ListCache[dnsimple.ZoneRecord]
+}
 
-type ZoneRecordCache map[string][]dnsimple.ZoneRecord
+// NewZoneRecordCache returns an empty cache whose zones expire after ttl. A
+// zero ttl keeps the zones until they are invalidated.
+func NewZoneRecordCache(ttl time.Duration) *ZoneRecordCache {
+	return &ZoneRecordCache{
+		records: NewListCache(ttl, func(record dnsimple.ZoneRecord) string {
+			return strconv.FormatInt(record.ID, 10)
+		}),
+	}
+}
 
-func NewZoneRecordCache() ZoneRecordCache {
-	return make(ZoneRecordCache)
+func zoneRecordCacheScope(accountID, zoneName string) string {
+	return accountID + "/" + zoneName
 }
 
-func (c ZoneRecordCache) Get(zoneName string) ([]dnsimple.ZoneRecord, bool) {
-	zoneRecordCacheMutex.RLock()
-	defer zoneRecordCacheMutex.RUnlock()
+// Get returns the cached records of the zone ordered by ID, and whether the
+// zone is cached.
+func (c *ZoneRecordCache) Get(accountID, zoneName string) ([]dnsimple.ZoneRecord, bool) {
+	records, ok := c.records.List(zoneRecordCacheScope(accountID, zoneName))
+	if !ok {
+		return nil, false
+	}
 
-	records, ok := c[zoneName]
-	return records, ok
+	slices.SortFunc(records, func(a, b dnsimple.ZoneRecord) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return records, true
 }
 
-func (c ZoneRecordCache) Set(zoneName string, records []dnsimple.ZoneRecord) {
-	zoneRecordCacheMutex.Lock()
-	defer zoneRecordCacheMutex.Unlock()
+// Set replaces the cached records of the zone.
+func (c *ZoneRecordCache) Set(accountID, zoneName string, records []dnsimple.ZoneRecord) {
+	c.records.Set(zoneRecordCacheScope(accountID, zoneName), records)
+}
 
-	c[zoneName] = records
+// FindByID returns the cached record of the zone with the given ID.
+func (c *ZoneRecordCache) FindByID(accountID, zoneName string, recordID int64) (dnsimple.ZoneRecord, bool) {
+	return c.records.Get(zoneRecordCacheScope(accountID, zoneName), strconv.FormatInt(recordID, 10))
 }
 
-func (c ZoneRecordCache) Find(zoneName, recordName, recordType string, content string) (dnsimple.ZoneRecord, bool) {
-	records, ok := c.Get(zoneName)
-	if !ok {
-		return dnsimple.ZoneRecord{}, false
-	}
+// Hydrate lists the records of the zone into the cache, unless it already
+// holds them. Concurrent calls for the same zone list it once.
+func (c *ZoneRecordCache) Hydrate(ctx context.Context, client *dnsimple.Client, accountID string, zoneName string, options *dnsimple.ZoneRecordListOptions) error {
+	return c.records.Hydrate(ctx, zoneRecordCacheScope(accountID, zoneName), func(ctx context.Context) ([]dnsimple.ZoneRecord, error) {
+		return ListAllZoneRecords(ctx, client, accountID, zoneName, options)
+	})
+}
 
-	for _, record := range records {
-		if record.Name == recordName && record.Type == recordType && record.Content == content {
-			return record, true
-		}
-	}
+// Upsert writes a created or updated record through to the cached zone. Zones
+// that are not cached are left alone, they get the record when hydrated.
+func (c *ZoneRecordCache) Upsert(accountID, zoneName string, record dnsimple.ZoneRecord) {
+	c.records.Upsert(zoneRecordCacheScope(accountID, zoneName), record)
+}
 
-	return dnsimple.ZoneRecord{}, false
+// Remove deletes a record from the cached zone.
+func (c *ZoneRecordCache) Remove(accountID, zoneName string, recordID int64) {
+	c.records.Remove(zoneRecordCacheScope(accountID, zoneName), strconv.FormatInt(recordID, 10))
 }
 
-func (c ZoneRecordCache) Hydrate(ctx context.Context, client *dnsimple.Client, accountId string, zoneName string, options *dnsimple.ZoneRecordListOptions) error {
-	if _, ok := c.Get(zoneName); !ok {
-		var records []dnsimple.ZoneRecord
+// Invalidate drops the cached records of the zone, so that the next Hydrate
+// lists them again.
+func (c *ZoneRecordCache) Invalidate(accountID, zoneName string) {
+	c.records.Invalidate(zoneRecordCacheScope(accountID, zoneName))
+}
 
-		if options == nil {
-			options = &dnsimple.ZoneRecordListOptions{}
-		}
+// ListAllZoneRecords walks every page of the zone records listing and returns
+// the records of the zone matching the given options.
+func ListAllZoneRecords(ctx context.Context, client *dnsimple.Client, accountId string, zoneName string, options *dnsimple.ZoneRecordListOptions) ([]dnsimple.ZoneRecord, error) {
+	var records []dnsimple.ZoneRecord
 
-		// Always use max page size
-		options.PerPage = dnsimple.Int(100)
-		// Fetch all records for the zone
-		for {
-			response, err := client.Zones.ListRecords(ctx, accountId, zoneName, options)
-			if err != nil {
-				return err
-			}
+	if options == nil {
+		options = &dnsimple.ZoneRecordListOptions{}
+	}
 
-			records = append(records, response.Data...)
+	// Always use max page size
+	options.PerPage = dnsimple.Int(100)
+	// Fetch all records for the zone
+	for {
+		response, err := client.Zones.ListRecords(ctx, accountId, zoneName, options)
+		if err != nil {
+			return nil, err
+		}
 
-			if response.Pagination.CurrentPage >= response.Pagination.TotalPages {
-				break
-			}
+		records = append(records, response.Data...)
 
-			options.Page = dnsimple.Int(response.Pagination.CurrentPage + 1)
+		if response.Pagination.CurrentPage >= response.Pagination.TotalPages {
+			break
 		}
 
-		c.Set(zoneName, records)
+		options.Page = dnsimple.Int(response.Pagination.CurrentPage + 1)
 	}
 
-	return nil
+	return records, nil
 }
@@ -1,7 +1,15 @@
 package common_test
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
 	"github.com/stretchr/testify/assert"
@@ -12,7 +20,7 @@ func TestZoneRecordCache(t *testing.T) {
 	t.Parallel()
 
 	// Set up a test cache.
-	cache := common.NewZoneRecordCache()
+	cache := common.NewZoneRecordCache(0)
 
 	// Prepare dnsimple.ZoneRecord set to add to cache.
 	records := []dnsimple.ZoneRecord{
@@ -45,18 +53,213 @@ func TestZoneRecordCache(t *testing.T) {
 	zoneName := "example.com"
 
 	// Add zone to cache
-	cache.Set(zoneName, records)
+	cache.Set("1010", zoneName, records)
 
 	// Get zone from cache
-	records, ok := cache.Get(zoneName)
+	records, ok := cache.Get("1010", zoneName)
 	assert.True(t, ok)
 
-	// Find record in zone
-	record, ok := cache.Find(zoneName, "a", "A", "1.2.3.4")
+	// Find record in zone by ID
+	record, ok := cache.FindByID("1010", zoneName, 3)
 	assert.True(t, ok)
-	assert.Equal(t, records[0], record)
+	assert.Equal(t, records[2], record)
 
-	// Find does not find record in zone
-	_, ok = cache.Find(zoneName, "b", "A", "1.2.3.5")
+	// FindByID does not find record in zone
+	_, ok = cache.FindByID("1010", zoneName, 5)
 	assert.False(t, ok)
+
+	// Zones are kept per account
+	_, ok = cache.Get("2020", zoneName)
+	assert.False(t, ok)
+	_, ok = cache.FindByID("2020", zoneName, 1)
+	assert.False(t, ok)
+}
+
+func TestZoneRecordCache_WriteThrough(t *testing.T) {
+	t.Parallel()
+
+	cache := common.NewZoneRecordCache(0)
+
+	// Mutations of zones that are not cached are dropped, the zone gets them
+	// when it is hydrated.
+	cache.Upsert("1010", "example.com", dnsimple.ZoneRecord{ID: 1, Name: "www", Type: "A", Content: "192.0.2.1"})
+	_, ok := cache.Get("1010", "example.com")
+	assert.False(t, ok)
+
+	cache.Set("1010", "example.com", []dnsimple.ZoneRecord{
+		{ID: 1, Name: "www", Type: "A", Content: "192.0.2.1"},
+	})
+
+	cache.Upsert("1010", "example.com", dnsimple.ZoneRecord{ID: 1, Name: "www", Type: "A", Content: "192.0.2.2"})
+	cache.Upsert("1010", "example.com", dnsimple.ZoneRecord{ID: 2, Name: "api", Type: "A", Content: "192.0.2.3"})
+
+	records, ok := cache.Get("1010", "example.com")
+	assert.True(t, ok)
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{ID: 1, Name: "www", Type: "A", Content: "192.0.2.2"},
+		{ID: 2, Name: "api", Type: "A", Content: "192.0.2.3"},
+	}, records)
+
+	cache.Remove("1010", "example.com", 1)
+
+	records, ok = cache.Get("1010", "example.com")
+	assert.True(t, ok)
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{ID: 2, Name: "api", Type: "A", Content: "192.0.2.3"},
+	}, records)
+
+	cache.Invalidate("1010", "example.com")
+
+	_, ok = cache.Get("1010", "example.com")
+	assert.False(t, ok)
+}
+
+func TestZoneRecordCache_ConcurrentHydrate(t *testing.T) {
+	t.Parallel()
+
+	server := newZoneRecordsServer(t, []dnsimple.ZoneRecord{
+		{ID: 1, ZoneID: "example.com", Name: "www", Type: "A", Content: "192.0.2.1"},
+	})
+	cache := common.NewZoneRecordCache(0)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, cache.Hydrate(context.Background(), server.client, "1010", "example.com", nil))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), server.listings.Load())
+
+	_, ok := cache.FindByID("1010", "example.com", 1)
+	assert.True(t, ok)
+
+	// Another account lists its own zone.
+	assert.NoError(t, cache.Hydrate(context.Background(), server.client, "2020", "example.com", nil))
+	assert.Equal(t, int32(2), server.listings.Load())
+}
+
+func TestZoneRecordCache_MutateDuringHydrate(t *testing.T) {
+	t.Parallel()
+
+	server := newZoneRecordsServer(t, []dnsimple.ZoneRecord{
+		{ID: 1, ZoneID: "example.com", Name: "www", Type: "A", Content: "192.0.2.1"},
+		{ID: 2, ZoneID: "example.com", Name: "old", Type: "A", Content: "192.0.2.2"},
+	})
+	server.block = make(chan struct{})
+	cache := common.NewZoneRecordCache(0)
+
+	hydrated := make(chan error)
+	go func() {
+		hydrated <- cache.Hydrate(context.Background(), server.client, "1010", "example.com", nil)
+	}()
+
+	// Wait for the listing to be in flight, then mutate the zone.
+	<-server.listing
+
+	mutated := make(chan struct{})
+	go func() {
+		defer close(mutated)
+		cache.Upsert("1010", "example.com", dnsimple.ZoneRecord{ID: 3, ZoneID: "example.com", Name: "new", Type: "A", Content: "192.0.2.3"})
+		cache.Remove("1010", "example.com", 2)
+	}()
+
+	// The mutations wait for the listing to complete.
+	select {
+	case <-mutated:
+		t.Fatal("zone mutated while it was being hydrated")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(server.block)
+	assert.NoError(t, <-hydrated)
+	<-mutated
+
+	records, ok := cache.Get("1010", "example.com")
+	assert.True(t, ok)
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{ID: 1, ZoneID: "example.com", Name: "www", Type: "A", Content: "192.0.2.1"},
+		{ID: 3, ZoneID: "example.com", Name: "new", Type: "A", Content: "192.0.2.3"},
+	}, records)
+}
+
+func TestZoneRecordCache_TTL(t *testing.T) {
+	t.Parallel()
+
+	server := newZoneRecordsServer(t, []dnsimple.ZoneRecord{
+		{ID: 1, ZoneID: "example.com", Name: "www", Type: "A", Content: "192.0.2.1"},
+	})
+	cache := common.NewZoneRecordCache(50 * time.Millisecond)
+
+	assert.NoError(t, cache.Hydrate(context.Background(), server.client, "1010", "example.com", nil))
+	assert.NoError(t, cache.Hydrate(context.Background(), server.client, "1010", "example.com", nil))
+	assert.Equal(t, int32(1), server.listings.Load())
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, ok := cache.Get("1010", "example.com")
+	assert.False(t, ok)
+
+	assert.NoError(t, cache.Hydrate(context.Background(), server.client, "1010", "example.com", nil))
+	assert.Equal(t, int32(2), server.listings.Load())
+}
+
+func TestZoneRecordCache_Invalidate(t *testing.T) {
+	t.Parallel()
+
+	server := newZoneRecordsServer(t, []dnsimple.ZoneRecord{
+		{ID: 1, ZoneID: "example.com", Name: "www", Type: "A", Content: "192.0.2.1"},
+	})
+	cache := common.NewZoneRecordCache(0)
+
+	assert.NoError(t, cache.Hydrate(context.Background(), server.client, "1010", "example.com", nil))
+	cache.Invalidate("1010", "example.com")
+	assert.NoError(t, cache.Hydrate(context.Background(), server.client, "1010", "example.com", nil))
+
+	assert.Equal(t, int32(2), server.listings.Load())
+}
+
+// zoneRecordsServer serves a single page of zone records and counts the
+// listings it serves.
+type zoneRecordsServer struct {
+	client   *dnsimple.Client
+	listings atomic.Int32
+	// listing receives a value when a listing starts, if block is set.
+	listing chan struct{}
+	// block holds the listings until it is closed, when set.
+	block chan struct{}
+}
+
+func newZoneRecordsServer(t *testing.T, records []dnsimple.ZoneRecord) *zoneRecordsServer {
+	t.Helper()
+
+	server := &zoneRecordsServer{listing: make(chan struct{}, 1)}
+
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !strings.HasSuffix(r.URL.Path, "/records") {
+			http.NotFound(w, r)
+			return
+		}
+
+		server.listings.Add(1)
+		if server.block != nil {
+			server.listing <- struct{}{}
+			<-server.block
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data":       records,
+			"pagination": dnsimple.Pagination{CurrentPage: 1, PerPage: 100, TotalEntries: len(records), TotalPages: 1},
+		})
+	}))
+	t.Cleanup(httpServer.Close)
+
+	server.client = dnsimple.NewClient(http.DefaultClient)
+	server.client.BaseURL = httpServer.URL
+
+	return server
 }
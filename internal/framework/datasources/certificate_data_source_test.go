@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/provider"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
 )
 
@@ -19,7 +18,7 @@ func TestAccCertificateDataSource(t *testing.T) {
 	certificateId := os.Getenv("DNSIMPLE_CERTIFICATE_ID")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: provider.NewProto6ProviderFactory(),
+		ProtoV6ProviderFactories: test_utils.TestAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCertificateDataSourceConfig(domain, certificateId),
@@ -0,0 +1,209 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServicesDataSource{}
+
+func NewServicesDataSource() datasource.DataSource {
+	return &ServicesDataSource{}
+}
+
+// ServicesDataSource defines the data source implementation.
+type ServicesDataSource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// ServicesDataSourceModel describes the data source data model.
+type ServicesDataSourceModel struct {
+	Id       types.String                `tfsdk:"id"`
+	Services []ServicesDataSourceService `tfsdk:"services"`
+}
+
+// ServicesDataSourceService describes a one-click service returned by the data source.
+type ServicesDataSourceService struct {
+	Id               types.Int64                 `tfsdk:"id"`
+	SID              types.String                `tfsdk:"sid"`
+	Name             types.String                `tfsdk:"name"`
+	Description      types.String                `tfsdk:"description"`
+	SetupDescription types.String                `tfsdk:"setup_description"`
+	RequiresSetup    types.Bool                  `tfsdk:"requires_setup"`
+	DefaultSubdomain types.String                `tfsdk:"default_subdomain"`
+	Settings         []ServicesDataSourceSetting `tfsdk:"settings"`
+}
+
+// ServicesDataSourceSetting describes a setting a one-click service requires.
+type ServicesDataSourceSetting struct {
+	Name        types.String `tfsdk:"name"`
+	Label       types.String `tfsdk:"label"`
+	Append      types.String `tfsdk:"append"`
+	Description types.String `tfsdk:"description"`
+	Example     types.String `tfsdk:"example"`
+	Password    types.Bool   `tfsdk:"password"`
+}
+
+func (d *ServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_services"
+}
+
+func (d *ServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple one-click services data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDStringAttribute(),
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "One-click services available in DNSimple",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Service ID",
+							Computed:            true,
+						},
+						"sid": schema.StringAttribute{
+							MarkdownDescription: "Short name of the service, which can be used in place of its ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Service name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Service description",
+							Computed:            true,
+						},
+						"setup_description": schema.StringAttribute{
+							MarkdownDescription: "Instructions to set the service up",
+							Computed:            true,
+						},
+						"requires_setup": schema.BoolAttribute{
+							MarkdownDescription: "True if the service requires settings to be applied",
+							Computed:            true,
+						},
+						"default_subdomain": schema.StringAttribute{
+							MarkdownDescription: "Subdomain the service is applied to by default",
+							Computed:            true,
+						},
+						"settings": schema.ListNestedAttribute{
+							MarkdownDescription: "Settings to give when applying the service",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Setting name, the key in the `settings` of `dnsimple_service`",
+										Computed:            true,
+									},
+									"label": schema.StringAttribute{
+										MarkdownDescription: "Human readable setting name",
+										Computed:            true,
+									},
+									"append": schema.StringAttribute{
+										MarkdownDescription: "Suffix appended to the setting value",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "Setting description",
+										Computed:            true,
+									},
+									"example": schema.StringAttribute{
+										MarkdownDescription: "Example setting value",
+										Computed:            true,
+									},
+									"password": schema.BoolAttribute{
+										MarkdownDescription: "True if the setting is a secret",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.config = config
+}
+
+func (d *ServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	services, err := common.ListAllServices(ctx, d.config.Client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Services",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue("services")
+	data.Services = make([]ServicesDataSourceService, 0, len(services))
+	for _, service := range services {
+		data.Services = append(data.Services, d.serviceFromAPIResponse(&service))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ServicesDataSource) serviceFromAPIResponse(service *dnsimple.Service) ServicesDataSourceService {
+	settings := make([]ServicesDataSourceSetting, 0, len(service.Settings))
+	for _, setting := range service.Settings {
+		settings = append(settings, ServicesDataSourceSetting{
+			Name:        types.StringValue(setting.Name),
+			Label:       types.StringValue(setting.Label),
+			Append:      types.StringValue(setting.Append),
+			Description: types.StringValue(setting.Description),
+			Example:     types.StringValue(setting.Example),
+			Password:    types.BoolValue(setting.Password),
+		})
+	}
+
+	return ServicesDataSourceService{
+		Id:               types.Int64Value(service.ID),
+		SID:              types.StringValue(service.SID),
+		Name:             types.StringValue(service.Name),
+		Description:      types.StringValue(service.Description),
+		SetupDescription: types.StringValue(service.SetupDescription),
+		RequiresSetup:    types.BoolValue(service.RequiresSetup),
+		DefaultSubdomain: types.StringValue(service.DefaultSubdomain),
+		Settings:         settings,
+	}
+}
@@ -0,0 +1,29 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccServicesDataSource(t *testing.T) {
+	dataSourceName := "data.dnsimple_services.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: test_utils.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "dnsimple_services" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "services"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "services.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "services.0.sid"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "services.0.name"),
+				),
+			},
+		},
+	})
+}
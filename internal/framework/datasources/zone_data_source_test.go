@@ -6,14 +6,13 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/provider"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
 )
 
 func TestAccZoneDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: provider.NewProto6ProviderFactory(),
+		ProtoV6ProviderFactories: test_utils.TestAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			// Read testing
 			{
@@ -0,0 +1,99 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneFileDataSource{}
+
+func NewZoneFileDataSource() datasource.DataSource {
+	return &ZoneFileDataSource{}
+}
+
+// ZoneFileDataSource defines the data source implementation.
+type ZoneFileDataSource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// ZoneFileDataSourceModel describes the data source data model.
+type ZoneFileDataSourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	ZoneName types.String `tfsdk:"zone_name"`
+	ZoneFile types.String `tfsdk:"zone_file"`
+}
+
+func (d *ZoneFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_file"
+}
+
+func (d *ZoneFileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple zone file data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDStringAttribute(),
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "Zone Name",
+				Required:            true,
+			},
+			"zone_file": schema.StringAttribute{
+				MarkdownDescription: "The zone rendered in the BIND zone file format",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ZoneFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.config = config
+}
+
+func (d *ZoneFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneFileDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.config.Client.Zones.GetZoneFile(ctx, d.config.AccountID, data.ZoneName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Zone File",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Id = data.ZoneName
+	data.ZoneFile = types.StringValue(response.Data.Zone)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
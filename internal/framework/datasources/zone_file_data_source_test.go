@@ -0,0 +1,37 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccZoneFileDataSource(t *testing.T) {
+	zoneName := os.Getenv("DNSIMPLE_DOMAIN")
+	dataSourceName := "data.dnsimple_zone_file.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: test_utils.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", zoneName),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexp.MustCompile(`SOA`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneFileDataSourceConfig(zoneName string) string {
+	return fmt.Sprintf(`
+data "dnsimple_zone_file" "test" {
+	zone_name = %[1]q
+}`, zoneName)
+}
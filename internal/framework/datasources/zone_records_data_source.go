@@ -0,0 +1,253 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneRecordsDataSource{}
+
+func NewZoneRecordsDataSource() datasource.DataSource {
+	return &ZoneRecordsDataSource{}
+}
+
+// ZoneRecordsDataSource defines the data source implementation.
+type ZoneRecordsDataSource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// ZoneRecordsDataSourceModel describes the data source data model.
+type ZoneRecordsDataSourceModel struct {
+	Id           types.String                  `tfsdk:"id"`
+	ZoneName     types.String                  `tfsdk:"zone_name"`
+	Name         types.String                  `tfsdk:"name"`
+	NamePrefix   types.String                  `tfsdk:"name_prefix"`
+	Type         types.String                  `tfsdk:"type"`
+	ContentRegex types.String                  `tfsdk:"content_regex"`
+	Records      []ZoneRecordsDataSourceRecord `tfsdk:"records"`
+}
+
+// ZoneRecordsDataSourceRecord describes a zone record returned by the data source.
+type ZoneRecordsDataSourceRecord struct {
+	Id            types.Int64  `tfsdk:"id"`
+	ZoneId        types.String `tfsdk:"zone_id"`
+	ParentId      types.Int64  `tfsdk:"parent_id"`
+	Name          types.String `tfsdk:"name"`
+	QualifiedName types.String `tfsdk:"qualified_name"`
+	Type          types.String `tfsdk:"type"`
+	Value         types.String `tfsdk:"value"`
+	TTL           types.Int64  `tfsdk:"ttl"`
+	Priority      types.Int64  `tfsdk:"priority"`
+	Regions       types.List   `tfsdk:"regions"`
+	SystemRecord  types.Bool   `tfsdk:"system_record"`
+}
+
+func (d *ZoneRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_records"
+}
+
+func (d *ZoneRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple zone records data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDStringAttribute(),
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "Zone Name",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return records with this exact name. Use an empty string for the zone apex",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return records whose name starts with this prefix",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return records of this type",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.RecordType{},
+				},
+			},
+			"content_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return records whose value matches this regular expression",
+				Optional:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "Records of the zone matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Record ID",
+							Computed:            true,
+						},
+						"zone_id": schema.StringAttribute{
+							MarkdownDescription: "Zone the record belongs to",
+							Computed:            true,
+						},
+						"parent_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the parent record, if the record was created by another one",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Record name, empty for the zone apex",
+							Computed:            true,
+						},
+						"qualified_name": schema.StringAttribute{
+							MarkdownDescription: "Fully qualified record name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Record type",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value",
+							Computed:            true,
+						},
+						"ttl": schema.Int64Attribute{
+							MarkdownDescription: "Record TTL",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Record priority",
+							Computed:            true,
+						},
+						"regions": schema.ListAttribute{
+							MarkdownDescription: "Regions the record is served from",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"system_record": schema.BoolAttribute{
+							MarkdownDescription: "True if the record is managed by DNSimple, such as the SOA and NS records of the zone",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZoneRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.config = config
+}
+
+func (d *ZoneRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneRecordsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var contentRegex *regexp.Regexp
+	if !data.ContentRegex.IsNull() {
+		var err error
+		contentRegex, err = regexp.Compile(data.ContentRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content_regex"),
+				"invalid content_regex",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	options := &dnsimple.ZoneRecordListOptions{
+		Name: data.Name.ValueStringPointer(),
+		Type: data.Type.ValueStringPointer(),
+	}
+	// The API only supports a "contains" match on names, the prefix is
+	// enforced when filtering the results below.
+	if !data.NamePrefix.IsNull() && data.NamePrefix.ValueString() != "" {
+		options.NameLike = data.NamePrefix.ValueStringPointer()
+	}
+
+	records, err := common.ListAllZoneRecords(ctx, d.config.Client, d.config.AccountID, data.ZoneName.ValueString(), options)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Zone Records",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Id = data.ZoneName
+	data.Records = []ZoneRecordsDataSourceRecord{}
+	for _, record := range records {
+		if !strings.HasPrefix(record.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+		if contentRegex != nil && !contentRegex.MatchString(record.Content) {
+			continue
+		}
+
+		data.Records = append(data.Records, d.recordFromAPIResponse(ctx, &record, data.ZoneName.ValueString(), resp))
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ZoneRecordsDataSource) recordFromAPIResponse(ctx context.Context, record *dnsimple.ZoneRecord, zoneName string, resp *datasource.ReadResponse) ZoneRecordsDataSourceRecord {
+	qualifiedName := zoneName
+	if record.Name != "" {
+		qualifiedName = fmt.Sprintf("%s.%s", record.Name, zoneName)
+	}
+
+	regions, diags := types.ListValueFrom(ctx, types.StringType, record.Regions)
+	resp.Diagnostics.Append(diags...)
+
+	return ZoneRecordsDataSourceRecord{
+		Id:            types.Int64Value(record.ID),
+		ZoneId:        types.StringValue(record.ZoneID),
+		ParentId:      types.Int64Value(record.ParentID),
+		Name:          types.StringValue(record.Name),
+		QualifiedName: types.StringValue(qualifiedName),
+		Type:          types.StringValue(record.Type),
+		Value:         types.StringValue(record.Content),
+		TTL:           types.Int64Value(int64(record.TTL)),
+		Priority:      types.Int64Value(int64(record.Priority)),
+		Regions:       regions,
+		SystemRecord:  types.BoolValue(record.SystemRecord),
+	}
+}
@@ -0,0 +1,85 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccZoneRecordsDataSource(t *testing.T) {
+	zoneName := os.Getenv("DNSIMPLE_DOMAIN")
+	dataSourceName := "data.dnsimple_zone_records.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: test_utils.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsDataSourceConfig(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", zoneName),
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.type", "TXT"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.ttl", "2800"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.system_record", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "records.0.id"),
+					resource.TestCheckResourceAttr("data.dnsimple_zone_records.allow", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.dnsimple_zone_records.allow", "records.0.value", "192.0.2.10"),
+					resource.TestCheckResourceAttr("data.dnsimple_zone_records.allow", "records.0.qualified_name", "tf-ds-allow."+zoneName),
+					resource.TestCheckResourceAttr("data.dnsimple_zone_records.ns", "records.0.system_record", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneRecordsDataSourceConfig(zoneName string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_zone_record" "txt_one" {
+	zone_name = %[1]q
+	name      = "tf-ds-txt-one"
+	type      = "TXT"
+	value     = "records data source"
+	ttl       = 2800
+}
+
+resource "dnsimple_zone_record" "txt_two" {
+	zone_name = %[1]q
+	name      = "tf-ds-txt-two"
+	type      = "TXT"
+	value     = "records data source"
+	ttl       = 2800
+}
+
+resource "dnsimple_zone_record" "allow" {
+	zone_name = %[1]q
+	name      = "tf-ds-allow"
+	type      = "A"
+	value     = "192.0.2.10"
+}
+
+data "dnsimple_zone_records" "test" {
+	zone_name   = %[1]q
+	name_prefix = "tf-ds-txt-"
+	type        = "TXT"
+
+	depends_on = [dnsimple_zone_record.txt_one, dnsimple_zone_record.txt_two]
+}
+
+data "dnsimple_zone_records" "allow" {
+	zone_name     = %[1]q
+	name_prefix   = "tf-ds-"
+	content_regex = "^192\\.0\\.2\\."
+
+	depends_on = [dnsimple_zone_record.allow]
+}
+
+data "dnsimple_zone_records" "ns" {
+	zone_name = %[1]q
+	name      = ""
+	type      = "NS"
+}`, zoneName)
+}
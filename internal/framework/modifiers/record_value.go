@@ -0,0 +1,113 @@
+package modifiers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+type recordValueNormalization struct{}
+
+// RecordValueNormalization returns a string plan modifier that keeps the prior
+// value of a record when the configured value only differs from it in form,
+// e.g. quoting of TXT records or the trailing dot of host names. The record
+// type is read from the sibling type attribute.
+func RecordValueNormalization() planmodifier.String {
+	return recordValueNormalization{}
+}
+
+func (m recordValueNormalization) Description(context.Context) string {
+	return "Keep the value in state when the configuration value is semantically equal for the record type"
+}
+
+func (m recordValueNormalization) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m recordValueNormalization) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	var recordType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("type"), &recordType)...)
+	if resp.Diagnostics.HasError() || recordType.IsNull() || recordType.IsUnknown() {
+		return
+	}
+
+	if RecordValuesEqual(recordType.ValueString(), req.ConfigValue.ValueString(), req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// RecordValuesEqual reports whether two values of a record of the given type
+// are semantically equal.
+func RecordValuesEqual(recordType string, a string, b string) bool {
+	return a == b || NormalizeRecordValue(recordType, a) == NormalizeRecordValue(recordType, b)
+}
+
+// NormalizeRecordValue returns the canonical form of a record value, so that
+// values DNS resolvers treat the same compare as equal.
+func NormalizeRecordValue(recordType string, value string) string {
+	value = strings.TrimSpace(value)
+
+	switch recordType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(value); ip != nil {
+			return ip.String()
+		}
+	case "ALIAS", "CNAME", "MX", "NS", "POOL", "PTR":
+		return normalizeHostname(value)
+	case "SRV":
+		fields := strings.Fields(value)
+		if len(fields) > 0 {
+			fields[len(fields)-1] = normalizeHostname(fields[len(fields)-1])
+		}
+		return strings.Join(fields, " ")
+	case "TXT", "SPF":
+		if strs, ok := utils.SplitTXTStrings(value); ok {
+			return strings.Join(strs, "")
+		}
+		return value
+	case "CAA":
+		return normalizeCAA(value)
+	}
+
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func normalizeHostname(name string) string {
+	if name == "." {
+		return name
+	}
+
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// normalizeCAA returns a CAA value as `flags tag "value"`, with the tag in
+// lower case and the value quoted.
+func normalizeCAA(value string) string {
+	fields := strings.Fields(value)
+	if len(fields) < 3 {
+		return value
+	}
+
+	flags, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return value
+	}
+
+	tag := fields[1]
+	tagValue := strings.TrimSpace(value[strings.Index(value, tag)+len(tag):])
+	if strs, ok := utils.SplitTXTStrings(tagValue); ok {
+		tagValue = strings.Join(strs, "")
+	}
+
+	return fmt.Sprintf("%d %s %q", flags, strings.ToLower(tag), tagValue)
+}
@@ -0,0 +1,124 @@
+package modifiers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/modifiers"
+)
+
+func TestRecordValuesEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		recordType string
+		a          string
+		b          string
+		equal      bool
+	}
+	tests := map[string]testCase{
+		"TXT quoted":              {recordType: "TXT", a: `"v=spf1 include:_spf.example.com -all"`, b: "v=spf1 include:_spf.example.com -all", equal: true},
+		"TXT split strings":       {recordType: "TXT", a: `"v=DKIM1; k=rsa; " "p=MIGf"`, b: "v=DKIM1; k=rsa; p=MIGf", equal: true},
+		"TXT escaped quotes":      {recordType: "TXT", a: `"say \"hi\""`, b: `say "hi"`, equal: true},
+		"TXT different":           {recordType: "TXT", a: `"v=spf1 -all"`, b: "v=spf1 ~all"},
+		"CNAME trailing dot":      {recordType: "CNAME", a: "example.com.", b: "example.com", equal: true},
+		"MX case":                 {recordType: "MX", a: "Mail.Example.com.", b: "mail.example.com", equal: true},
+		"SRV target trailing dot": {recordType: "SRV", a: "60 5060 sip.example.com.", b: "60  5060 sip.example.com", equal: true},
+		"SRV different port":      {recordType: "SRV", a: "60 5061 sip.example.com", b: "60 5060 sip.example.com"},
+		"AAAA compressed":         {recordType: "AAAA", a: "2001:0db8:0:0::1", b: "2001:db8::1", equal: true},
+		"AAAA different":          {recordType: "AAAA", a: "2001:db8::2", b: "2001:db8::1"},
+		"A same":                  {recordType: "A", a: "192.0.2.1", b: "192.0.2.1", equal: true},
+		"CAA tag case":            {recordType: "CAA", a: `0 ISSUE "letsencrypt.org"`, b: `0 issue "letsencrypt.org"`, equal: true},
+		"CAA unquoted value":      {recordType: "CAA", a: "0 issue letsencrypt.org", b: `0 issue "letsencrypt.org"`, equal: true},
+		"CAA different flags":     {recordType: "CAA", a: `128 issue "letsencrypt.org"`, b: `0 issue "letsencrypt.org"`},
+		"NAPTR spacing":           {recordType: "NAPTR", a: `100  10 "S" "SIP+D2U" "" _sip._udp.example.com.`, b: `100 10 "S" "SIP+D2U" "" _sip._udp.example.com.`, equal: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.equal, modifiers.RecordValuesEqual(test.recordType, test.a, test.b))
+		})
+	}
+}
+
+func TestRecordValueNormalization(t *testing.T) {
+	t.Parallel()
+
+	recordSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type":  schema.StringAttribute{Required: true},
+			"value": schema.StringAttribute{Required: true},
+		},
+	}
+
+	type testCase struct {
+		recordType    string
+		configValue   types.String
+		stateValue    types.String
+		expectedValue types.String
+	}
+	tests := map[string]testCase{
+		"semantically equal keeps state": {
+			recordType:    "TXT",
+			configValue:   types.StringValue("v=spf1 -all"),
+			stateValue:    types.StringValue(`"v=spf1 -all"`),
+			expectedValue: types.StringValue(`"v=spf1 -all"`),
+		},
+		"changed value is planned": {
+			recordType:    "TXT",
+			configValue:   types.StringValue("v=spf1 ~all"),
+			stateValue:    types.StringValue(`"v=spf1 -all"`),
+			expectedValue: types.StringValue("v=spf1 ~all"),
+		},
+		"create": {
+			recordType:    "CNAME",
+			configValue:   types.StringValue("example.com."),
+			stateValue:    types.StringNull(),
+			expectedValue: types.StringValue("example.com."),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			config := tfsdk.Config{
+				Schema: recordSchema,
+				Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"type":  tftypes.String,
+					"value": tftypes.String,
+				}}, map[string]tftypes.Value{
+					"type":  tftypes.NewValue(tftypes.String, test.recordType),
+					"value": tftypes.NewValue(tftypes.String, test.configValue.ValueString()),
+				}),
+			}
+			request := planmodifier.StringRequest{
+				Path:        path.Root("value"),
+				Config:      config,
+				ConfigValue: test.configValue,
+				PlanValue:   test.configValue,
+				StateValue:  test.stateValue,
+			}
+			response := planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+			modifiers.RecordValueNormalization().PlanModifyString(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			assert.Equal(t, test.expectedValue, response.PlanValue)
+		})
+	}
+}
@@ -7,13 +7,17 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/consts"
@@ -22,26 +26,53 @@ import (
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources/registered_domain"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/validators"
 	"golang.org/x/oauth2"
 )
 
 var _ provider.Provider = &DnsimpleProvider{}
 
+// zoneRecordBatchWindow is how long zone record changes are gathered before
+// they are sent as a batch. Terraform applies independent resources at the
+// same time, so their changes reach the provider within a few milliseconds.
+const zoneRecordBatchWindow = 250 * time.Millisecond
+
 type DnsimpleProvider struct {
 	// version is set to the provider version on release, "dev" when the
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// baseURL overrides the DNSimple API endpoint. It is only set by tests
+	// running against a local mock of the API.
+	baseURL string
+}
+
+// ProviderOption customizes the provider built by NewProto6ProviderFactory.
+type ProviderOption func(*DnsimpleProvider)
+
+// WithBaseURL points the provider's API client at the given base URL instead
+// of the production or sandbox API.
+func WithBaseURL(baseURL string) ProviderOption {
+	return func(p *DnsimpleProvider) {
+		p.baseURL = baseURL
+	}
 }
 
 // DnsimpleProviderModel describes the provider data model.
 type DnsimpleProviderModel struct {
-	Token              types.String `tfsdk:"token"`
-	Account            types.String `tfsdk:"account"`
-	Sandbox            types.Bool   `tfsdk:"sandbox"`
-	Prefetch           types.Bool   `tfsdk:"prefetch"`
-	UserAgentExtra     types.String `tfsdk:"user_agent"`
-	DebugTransportFile types.String `tfsdk:"debug_transport_file"`
+	Token              types.String  `tfsdk:"token"`
+	Account            types.String  `tfsdk:"account"`
+	Sandbox            types.Bool    `tfsdk:"sandbox"`
+	Prefetch           types.Bool    `tfsdk:"prefetch"`
+	PrefetchTTL        types.String  `tfsdk:"prefetch_ttl"`
+	BatchRecordChanges types.Bool    `tfsdk:"batch_record_changes"`
+	UserAgentExtra     types.String  `tfsdk:"user_agent"`
+	DebugTransportFile types.String  `tfsdk:"debug_transport_file"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	MaxRetryWait       types.String  `tfsdk:"max_retry_wait"`
+	MaxRequestsPerHour types.Int64   `tfsdk:"max_requests_per_hour"`
+	RequestsPerSecond  types.Float64 `tfsdk:"requests_per_second"`
 }
 
 // debugTransport is an HTTP transport that logs requests and responses
@@ -141,6 +172,17 @@ func (p *DnsimpleProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:            true,
 				MarkdownDescription: "Flag to enable the prefetch of zone records.",
 			},
+			"prefetch_ttl": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How long prefetched zone records are reused before the zone is listed again, given as a duration such as `30s` or `10m`. By default the records are kept for the whole run.",
+				Validators: []validator.String{
+					validators.Duration{},
+				},
+			},
+			"batch_record_changes": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag to send the zone record changes of an apply through the batch change endpoint, grouped per zone.",
+			},
 			"user_agent": schema.StringAttribute{
 				Optional:    true,
 				Description: "Custom string to append to the user agent used for sending HTTP requests to the API.",
@@ -149,6 +191,25 @@ func (p *DnsimpleProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:            true,
 				MarkdownDescription: "File path to enable HTTP request/response debugging. When set, all HTTP requests and responses will be logged to this file.",
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of times a request is retried when the API is rate limiting or returns a server error. Set to `0` to disable retries. Defaults to `3`.",
+			},
+			"max_retry_wait": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum total time a request waits between retries, given as a duration such as `30s` or `10m`. Defaults to `5m`.",
+				Validators: []validator.String{
+					validators.Duration{},
+				},
+			},
+			"max_requests_per_hour": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of API requests the provider sends per hour. Requests are spread evenly over the hour. Conflicts with `requests_per_second`.",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of API requests the provider sends per second. Conflicts with `max_requests_per_hour`.",
+			},
 		},
 		MarkdownDescription: "The DNSimple provider is used to interact with the various services that DNSimple offers. " +
 			"The provider needs to be configured with the proper credentials before it can be used.",
@@ -160,10 +221,14 @@ func (p *DnsimpleProvider) Configure(ctx context.Context, req provider.Configure
 	var (
 		data DnsimpleProviderModel
 
-		token    string
-		account  string
-		sandbox  bool
-		prefetch bool
+		token        string
+		account      string
+		sandbox      bool
+		prefetch     bool
+		prefetchTTL  time.Duration
+		batch        bool
+		maxRetries   = defaultMaxRetries
+		maxRetryWait = defaultMaxRetryWait
 	)
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -217,6 +282,41 @@ func (p *DnsimpleProvider) Configure(ctx context.Context, req provider.Configure
 		prefetch = data.Prefetch.ValueBool()
 	}
 
+	if data.BatchRecordChanges.IsNull() || data.BatchRecordChanges.IsUnknown() {
+		batch = utils.GetDefaultFromEnv("DNSIMPLE_BATCH_RECORD_CHANGES", "") != ""
+	} else {
+		batch = data.BatchRecordChanges.ValueBool()
+	}
+
+	if !data.PrefetchTTL.IsNull() && !data.PrefetchTTL.IsUnknown() {
+		// The value was already checked by the Duration validator.
+		prefetchTTL, _ = time.ParseDuration(data.PrefetchTTL.ValueString())
+	}
+
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	if maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"failed to configure DNSimple provider",
+			"The maximum number of retries must be zero or greater",
+		)
+		return
+	}
+
+	if !data.MaxRetryWait.IsNull() && !data.MaxRetryWait.IsUnknown() {
+		// The value was already checked by the Duration validator.
+		maxRetryWait, _ = time.ParseDuration(data.MaxRetryWait.ValueString())
+	}
+
+	rateLimiter, diags := newRateLimiter(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
 	tc := oauth2.NewClient(ctx, ts)
 
@@ -228,6 +328,14 @@ func (p *DnsimpleProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
+	if rateLimiter != nil {
+		tc.Transport = &throttleTransport{Base: tc.Transport, Limiter: rateLimiter}
+	}
+
+	// Retry rate limited and failed requests. The retry transport wraps the
+	// others so that every attempt is throttled, authenticated and logged.
+	tc.Transport = newRetryTransport(tc.Transport, maxRetries, maxRetryWait)
+
 	client := dnsimple.NewClient(tc)
 
 	userAgent := fmt.Sprintf("terraform/%s terraform-provider-dnsimple/%s", req.TerraformVersion, p.version)
@@ -240,26 +348,91 @@ func (p *DnsimpleProvider) Configure(ctx context.Context, req provider.Configure
 		client.BaseURL = consts.BaseURLSandbox
 	}
 
+	if p.baseURL != "" {
+		client.BaseURL = p.baseURL
+	}
+
 	providerData := &common.DnsimpleProviderConfig{
-		Client:          client,
-		AccountID:       account,
-		Prefetch:        prefetch,
-		ZoneRecordCache: common.ZoneRecordCache{},
+		Client:                      client,
+		AccountID:                   account,
+		Prefetch:                    prefetch,
+		ZoneRecordCache:             common.NewZoneRecordCache(prefetchTTL),
+		DomainCache:                 common.NewDomainCache(prefetchTTL),
+		EmailForwardCache:           common.NewEmailForwardCache(prefetchTTL),
+		DelegationSignerRecordCache: common.NewDelegationSignerRecordCache(prefetchTTL),
+		RateLimiter:                 rateLimiter,
+	}
+	if batch {
+		providerData.ZoneRecordBatcher = common.NewZoneRecordBatcher(client, zoneRecordBatchWindow)
 	}
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
 }
 
+// newRateLimiter builds the rate limiter configured by either
+// max_requests_per_hour or requests_per_second. It returns nil when neither is
+// set, which disables client-side throttling.
+func newRateLimiter(data DnsimpleProviderModel) (*common.RateLimiter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	perHourSet := !data.MaxRequestsPerHour.IsNull() && !data.MaxRequestsPerHour.IsUnknown()
+	perSecondSet := !data.RequestsPerSecond.IsNull() && !data.RequestsPerSecond.IsUnknown()
+
+	switch {
+	case perHourSet && perSecondSet:
+		diags.AddAttributeError(
+			path.Root("requests_per_second"),
+			"failed to configure DNSimple provider",
+			"Only one of max_requests_per_hour and requests_per_second can be set",
+		)
+	case perHourSet:
+		if data.MaxRequestsPerHour.ValueInt64() <= 0 {
+			diags.AddAttributeError(
+				path.Root("max_requests_per_hour"),
+				"failed to configure DNSimple provider",
+				"The maximum number of requests per hour must be greater than zero",
+			)
+			return nil, diags
+		}
+		return common.NewHourlyRateLimiter(data.MaxRequestsPerHour.ValueInt64()), diags
+	case perSecondSet:
+		requestsPerSecond := data.RequestsPerSecond.ValueFloat64()
+		if requestsPerSecond <= 0 {
+			diags.AddAttributeError(
+				path.Root("requests_per_second"),
+				"failed to configure DNSimple provider",
+				"The number of requests per second must be greater than zero",
+			)
+			return nil, diags
+		}
+		return common.NewRateLimiter(requestsPerSecond, int(requestsPerSecond)), diags
+	}
+
+	return nil, diags
+}
+
 func (p *DnsimpleProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewContactResource,
 		resources.NewDomainDelegationResource,
+		resources.NewDomainPushResource,
+		resources.NewDomainPushAcceptResource,
 		resources.NewDomainResource,
 		registered_domain.NewRegisteredDomainResource,
 		resources.NewDsRecordResource,
 		resources.NewEmailForwardResource,
 		resources.NewLetsEncryptCertificateResource,
+		resources.NewSecondaryZoneResource,
+		resources.NewServiceResource,
+		resources.NewTemplateResource,
+		resources.NewTemplateApplicationResource,
+		resources.NewTemplateRecordResource,
+		resources.NewVanityNameServersResource,
+		resources.NewWebhookResource,
+		resources.NewZoneFileResource,
+		resources.NewZoneNsRecordsResource,
 		resources.NewZoneRecordResource,
+		resources.NewZoneRecordsResource,
 		resources.NewZoneResource,
 	}
 }
@@ -269,7 +442,10 @@ func (p *DnsimpleProvider) DataSources(ctx context.Context) []func() datasource.
 	return []func() datasource.DataSource{
 		datasources.NewCertificateDataSource,
 		datasources.NewRegistrantChangeCheckDataSource,
+		datasources.NewServicesDataSource,
 		datasources.NewZoneDataSource,
+		datasources.NewZoneFileDataSource,
+		datasources.NewZoneRecordsDataSource,
 	}
 }
 
@@ -282,8 +458,17 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-func NewProto6ProviderFactory() map[string]func() (tfprotov6.ProviderServer, error) {
+// NewProto6ProviderFactory returns the provider factories used by the
+// acceptance tests, with the given options applied to the provider.
+func NewProto6ProviderFactory(opts ...ProviderOption) map[string]func() (tfprotov6.ProviderServer, error) {
+	p := &DnsimpleProvider{
+		version: "test",
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
 	return map[string]func() (tfprotov6.ProviderServer, error){
-		"dnsimple": providerserver.NewProtocol6WithError(New("test")()),
+		"dnsimple": providerserver.NewProtocol6WithError(p),
 	}
 }
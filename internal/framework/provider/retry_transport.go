@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultMaxRetryWait = 5 * time.Minute
+
+	retryMinBackoff = time.Second
+	retryMaxBackoff = 30 * time.Second
+)
+
+// retryTransport is an HTTP transport that retries requests rejected by the
+// API because of rate limiting or a transient server error.
+//
+// Requests answered with a 429 are retried once the rate limit window resets,
+// as announced by the X-RateLimit-Reset header. Idempotent requests answered
+// with a 5xx are retried with a jittered exponential backoff. When a response
+// reports that no requests are left in the window, the next request waits for
+// the reset instead of being rejected.
+//
+// A request is retried at most MaxRetries times, and never waits longer than
+// MaxWait in total.
+type retryTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+	MaxWait    time.Duration
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	mu             sync.Mutex
+	rateLimitReset time.Time
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int, maxWait time.Duration) *retryTransport {
+	return &retryTransport{
+		Base:       base,
+		MaxRetries: maxRetries,
+		MaxWait:    maxWait,
+		minBackoff: retryMinBackoff,
+		maxBackoff: retryMaxBackoff,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	var waited time.Duration
+
+	if wait := t.rateLimitWait(); wait > 0 && wait <= t.MaxWait {
+		tflog.Info(ctx, "DNSimple API rate limit exhausted, waiting for the window to reset", map[string]interface{}{
+			"wait": wait.String(),
+		})
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+		waited += wait
+	}
+
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		resp, err := base.RoundTrip(attemptReq)
+		if err != nil {
+			return resp, err
+		}
+		t.recordRateLimit(resp)
+
+		wait, retry := t.retryWait(req, resp, attempt)
+		if !retry || attempt >= t.MaxRetries || waited+wait > t.MaxWait {
+			return resp, nil
+		}
+
+		nextReq, err := rewindRequest(req)
+		if err != nil {
+			return resp, nil
+		}
+
+		tflog.Warn(ctx, "[RETRYING] DNSimple API request", map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		})
+
+		// Drain the body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+		waited += wait
+		attemptReq = nextReq
+	}
+}
+
+// retryWait reports whether the response warrants a retry and how long to wait
+// before sending the request again.
+func (t *retryTransport) retryWait(req *http.Request, resp *http.Response, attempt int) (time.Duration, bool) {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		if reset, ok := rateLimitResetTime(resp); ok {
+			if wait := time.Until(reset); wait > 0 {
+				return wait, true
+			}
+		}
+		return t.backoff(attempt), true
+	case resp.StatusCode >= http.StatusInternalServerError && isIdempotent(req.Method):
+		return t.backoff(attempt), true
+	default:
+		return 0, false
+	}
+}
+
+// backoff returns an exponential backoff for the given attempt with equal
+// jitter, so that concurrent clients do not retry in lockstep.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	backoff := t.maxBackoff
+	if attempt < 30 {
+		backoff = min(t.minBackoff<<attempt, t.maxBackoff)
+	}
+	half := backoff / 2
+
+	return half + rand.N(half+1)
+}
+
+// recordRateLimit remembers when the rate limit window resets if the response
+// reports that no requests are left in the current one.
+func (t *retryTransport) recordRateLimit(resp *http.Response) {
+	remaining := resp.Header.Get("X-RateLimit-Remaining")
+	if remaining == "" {
+		return
+	}
+	if value, err := strconv.Atoi(remaining); err != nil || value > 0 {
+		return
+	}
+	reset, ok := rateLimitResetTime(resp)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if reset.After(t.rateLimitReset) {
+		t.rateLimitReset = reset
+	}
+}
+
+// rateLimitWait returns how long to wait before the rate limit window resets,
+// or zero when requests are still allowed.
+func (t *retryTransport) rateLimitWait() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return time.Until(t.rateLimitReset)
+}
+
+func rateLimitResetTime(resp *http.Response) (time.Time, bool) {
+	value, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(value, 0), true
+}
+
+// isIdempotent reports whether a request is safe to repeat. DNSimple updates
+// are PATCH requests that send the full set of attributes, so they are too.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// rewindRequest returns a copy of the request with a fresh body, ready to be
+// sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+
+	return next, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRetryClient returns a client whose transport retries without
+// noticeable delays against a test server running the given handler, along
+// with the URL of the server.
+func newTestRetryClient(t *testing.T, maxRetries int, maxWait time.Duration, handler http.HandlerFunc) (*http.Client, string) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	transport := newRetryTransport(http.DefaultTransport, maxRetries, maxWait)
+	transport.minBackoff = time.Millisecond
+	transport.maxBackoff = 5 * time.Millisecond
+
+	return &http.Client{Transport: transport}, server.URL
+}
+
+// statusSequence answers with the given status codes in order, then succeeds.
+func statusSequence(calls *atomic.Int32, statuses ...int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1)) - 1
+		if call < len(statuses) {
+			w.WriteHeader(statuses[call])
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func TestRetryTransport_RetriesServerErrorsOnIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+	client, url := newTestRetryClient(t, 3, time.Minute, statusSequence(&calls, http.StatusBadGateway, http.StatusServiceUnavailable))
+
+	resp, err := client.Get(url + "/v2/whoami")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryTransport_RetriesServerErrorsOnPatch(t *testing.T) {
+	var calls atomic.Int32
+	client, url := newTestRetryClient(t, 3, time.Minute, statusSequence(&calls, http.StatusBadGateway))
+
+	req, err := http.NewRequest(http.MethodPatch, url+"/v2/1010/zones/example.com/records/1", bytes.NewBufferString(`{"ttl":300}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetryTransport_DoesNotRetryServerErrorsOnPost(t *testing.T) {
+	var calls atomic.Int32
+	client, url := newTestRetryClient(t, 3, time.Minute, statusSequence(&calls, http.StatusInternalServerError))
+
+	resp, err := client.Post(url+"/v2/1010/domains", "application/json", bytes.NewBufferString(`{"name":"example.com"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_RetriesRateLimitedRequestsWithBody(t *testing.T) {
+	var calls atomic.Int32
+	var bodies []string
+	client, url := newTestRetryClient(t, 3, time.Minute, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if calls.Add(1) == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	resp, err := client.Post(url+"/v2/1010/domains", "application/json", bytes.NewBufferString(`{"name":"example.com"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, []string{`{"name":"example.com"}`, `{"name":"example.com"}`}, bodies)
+}
+
+func TestRetryTransport_StopsAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	client, url := newTestRetryClient(t, 2, time.Minute, statusSequence(&calls, 500, 500, 500, 500))
+
+	resp, err := client.Get(url + "/v2/whoami")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryTransport_StopsWhenResetExceedsMaxWait(t *testing.T) {
+	var calls atomic.Int32
+	client, url := newTestRetryClient(t, 3, time.Minute, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	resp, err := client.Get(url + "/v2/whoami")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := newRetryTransport(nil, 3, time.Minute)
+
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second} {
+		backoff := transport.backoff(attempt)
+		assert.GreaterOrEqual(t, backoff, expected/2)
+		assert.LessOrEqual(t, backoff, expected)
+	}
+	assert.LessOrEqual(t, transport.backoff(100), retryMaxBackoff)
+}
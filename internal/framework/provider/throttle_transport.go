@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+// throttleTransport is an HTTP transport that paces requests through the rate
+// limiter shared by every resource and data source of the provider.
+type throttleTransport struct {
+	Base    http.RoundTripper
+	Limiter *common.RateLimiter
+}
+
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.Limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        DnsimpleProviderModel
+		wantLimiter bool
+		wantError   bool
+	}{
+		{
+			name: "disabled by default",
+			data: DnsimpleProviderModel{
+				MaxRequestsPerHour: types.Int64Null(),
+				RequestsPerSecond:  types.Float64Null(),
+			},
+		},
+		{
+			name: "per hour",
+			data: DnsimpleProviderModel{
+				MaxRequestsPerHour: types.Int64Value(2400),
+				RequestsPerSecond:  types.Float64Null(),
+			},
+			wantLimiter: true,
+		},
+		{
+			name: "per second",
+			data: DnsimpleProviderModel{
+				MaxRequestsPerHour: types.Int64Null(),
+				RequestsPerSecond:  types.Float64Value(0.5),
+			},
+			wantLimiter: true,
+		},
+		{
+			name: "both set",
+			data: DnsimpleProviderModel{
+				MaxRequestsPerHour: types.Int64Value(2400),
+				RequestsPerSecond:  types.Float64Value(1),
+			},
+			wantError: true,
+		},
+		{
+			name: "zero per hour",
+			data: DnsimpleProviderModel{
+				MaxRequestsPerHour: types.Int64Value(0),
+				RequestsPerSecond:  types.Float64Null(),
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, diags := newRateLimiter(tt.data)
+
+			assert.Equal(t, tt.wantError, diags.HasError())
+			assert.Equal(t, tt.wantLimiter, limiter != nil)
+		})
+	}
+}
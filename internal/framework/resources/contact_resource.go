@@ -20,9 +20,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ContactResource{}
-	_ resource.ResourceWithConfigure   = &ContactResource{}
-	_ resource.ResourceWithImportState = &ContactResource{}
+	_ resource.Resource                 = &ContactResource{}
+	_ resource.ResourceWithConfigure    = &ContactResource{}
+	_ resource.ResourceWithImportState  = &ContactResource{}
+	_ resource.ResourceWithUpgradeState = &ContactResource{}
 )
 
 func NewContactResource() resource.Resource {
@@ -66,6 +67,7 @@ func (r *ContactResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "DNSimple contact resource",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"id": common.IDInt64Attribute(),
 			"account_id": schema.Int64Attribute{
@@ -323,6 +325,60 @@ func (r *ContactResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
+func (r *ContactResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeContactStateV0},
+	}
+}
+
+// upgradeContactStateV0 upgrades the state written by the provider versions
+// prior to the normalized phone and fax numbers.
+func upgradeContactStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	prior, err := newRawState(req.RawState)
+	if err != nil {
+		resp.Diagnostics.Append(stateUpgradeErrorDiagnostics("dnsimple_contact", 0, err)...)
+		return
+	}
+
+	data := ContactResourceModel{
+		Id:               prior.Int64("id"),
+		AccountId:        prior.Int64("account_id"),
+		Label:            prior.String("label"),
+		FirstName:        prior.String("first_name"),
+		LastName:         prior.String("last_name"),
+		OrganizationName: prior.String("organization_name"),
+		JobTitle:         prior.String("job_title"),
+		Address1:         prior.String("address1"),
+		Address2:         prior.String("address2"),
+		City:             prior.String("city"),
+		StateProvince:    prior.String("state_province"),
+		PostalCode:       prior.String("postal_code"),
+		Country:          prior.String("country"),
+		Phone:            prior.String("phone"),
+		PhoneNormalized:  prior.String("phone_normalized"),
+		Fax:              prior.String("fax"),
+		FaxNormalized:    prior.String("fax_normalized"),
+		Email:            prior.String("email"),
+		CreatedAt:        prior.String("created_at"),
+		UpdatedAt:        prior.String("updated_at"),
+	}
+	if err := prior.Err(); err != nil {
+		resp.Diagnostics.Append(stateUpgradeErrorDiagnostics("dnsimple_contact", 0, err)...)
+		return
+	}
+
+	// The numbers were written as configured before the normalized numbers
+	// existed. They are refreshed from the API right after the upgrade.
+	if !prior.Has("phone_normalized") {
+		data.PhoneNormalized = data.Phone
+	}
+	if !prior.Has("fax_normalized") {
+		data.FaxNormalized = data.Fax
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
 func (r *ContactResource) updateModelFromAPIResponse(contact *dnsimple.Contact, data *ContactResourceModel) {
 	data.Id = types.Int64Value(contact.ID)
 	data.AccountId = types.Int64Value(contact.AccountID)
@@ -157,6 +157,7 @@ func (r *DsRecordResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	r.config.DelegationSignerRecordCache.Upsert(common.DomainCacheScope(r.config.AccountID, data.Domain.ValueString()), *response.Data)
 	r.updateModelFromAPIResponse(response.Data, data)
 
 	// Save data into Terraform state
@@ -173,16 +174,40 @@ func (r *DsRecordResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	response, err := r.config.Client.Domains.GetDelegationSignerRecord(ctx, r.config.AccountID, data.Domain.ValueString(), data.Id.ValueInt64())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"failed to read DNSimple DS Record",
-			fmt.Sprintf("Unable to read DS record with ID %d: %s", data.Id.ValueInt64(), err.Error()),
-		)
-		return
+	var ds *dnsimple.DelegationSignerRecord
+
+	if r.config.Prefetch {
+		scope := common.DomainCacheScope(r.config.AccountID, data.Domain.ValueString())
+		err := r.config.DelegationSignerRecordCache.Hydrate(ctx, scope, func(ctx context.Context) ([]dnsimple.DelegationSignerRecord, error) {
+			return common.ListAllDelegationSignerRecords(ctx, r.config.Client, r.config.AccountID, data.Domain.ValueString())
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to hydrate DS record cache",
+				err.Error(),
+			)
+			return
+		}
+
+		if cached, ok := r.config.DelegationSignerRecordCache.Get(scope, strconv.FormatInt(data.Id.ValueInt64(), 10)); ok {
+			ds = &cached
+		}
 	}
 
-	r.updateModelFromAPIResponse(response.Data, data)
+	if ds == nil {
+		response, err := r.config.Client.Domains.GetDelegationSignerRecord(ctx, r.config.AccountID, data.Domain.ValueString(), data.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to read DNSimple DS Record",
+				fmt.Sprintf("Unable to read DS record with ID %d: %s", data.Id.ValueInt64(), err.Error()),
+			)
+			return
+		}
+
+		ds = response.Data
+	}
+
+	r.updateModelFromAPIResponse(ds, data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -211,7 +236,10 @@ func (r *DsRecordResource) Delete(ctx context.Context, req resource.DeleteReques
 			"failed to delete DNSimple DS Record",
 			fmt.Sprintf("Unable to delete DS record with ID %d: %s", data.Id.ValueInt64(), err.Error()),
 		)
+		return
 	}
+
+	r.config.DelegationSignerRecordCache.Remove(common.DomainCacheScope(r.config.AccountID, data.Domain.ValueString()), strconv.FormatInt(data.Id.ValueInt64(), 10))
 }
 
 func (r *DsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,153 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+const (
+	DomainPushConverged          = "domain_push_converged"
+	DomainPushConvergenceTimeout = "domain_push_converged_timeout"
+	DomainPushFailed             = "domain_push_failed"
+
+	// defaultDomainPushTimeout is how long to wait for a push to be accepted
+	// when no create or update timeout is set.
+	defaultDomainPushTimeout = 10 * time.Minute
+
+	// domainPushPollInterval is the delay between two checks of a push.
+	domainPushPollInterval = 20 * time.Second
+)
+
+// tryToConvergeDomainPush waits until the pushed domain has left the account,
+// which is the only sign the pushing account gets that the push was
+// accepted.
+func tryToConvergeDomainPush(ctx context.Context, data *DomainPushResourceModel, diagnostics *diag.Diagnostics, r *DomainPushResource, timeout time.Duration) (string, error) {
+	err := utils.RetryWithTimeout(ctx, func() (error, bool) {
+		_, err := r.config.Client.Domains.GetDomain(ctx, r.config.AccountID, strconv.FormatInt(data.DomainId.ValueInt64(), 10))
+		if err == nil {
+			tflog.Info(ctx, fmt.Sprintf("[RETRYING] Domain push of '%s' is not accepted yet", data.Domain.ValueString()))
+
+			return fmt.Errorf("domain push of '%s' is not accepted yet. You can try to run terraform again to try and converge the domain push", data.Domain.ValueString()), false
+		}
+
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			return nil, false
+		}
+
+		diagnostics.AddError(
+			"failed to read DNSimple Domain",
+			fmt.Sprintf("Unable to read domain '%s': %s", data.Domain.ValueString(), err.Error()),
+		)
+		return nil, true
+	}, timeout, domainPushPollInterval)
+
+	if diagnostics.HasError() {
+		// If we have diagnostic errors, we suspended the retry loop because the push cannot be followed.
+		return DomainPushFailed, nil
+	}
+
+	if err != nil {
+		// If we have an error, it means the retry loop timed out, and we cannot converge during this run.
+		return DomainPushConvergenceTimeout, err
+	}
+
+	return DomainPushConverged, nil
+}
+
+// tryToConvergeDomainPushAcceptance waits until the domain is in the account.
+// While it is not, the pending push of the domain is accepted, unless it was
+// already accepted by a previous run.
+func tryToConvergeDomainPushAcceptance(ctx context.Context, data *DomainPushAcceptResourceModel, diagnostics *diag.Diagnostics, r *DomainPushAcceptResource, timeout time.Duration) (string, error) {
+	domainID := strconv.FormatInt(data.DomainId.ValueInt64(), 10)
+
+	err := utils.RetryWithTimeout(ctx, func() (error, bool) {
+		domainResponse, err := r.config.Client.Domains.GetDomain(ctx, r.config.AccountID, domainID)
+		if err == nil {
+			// The push was accepted, possibly outside of Terraform.
+			data.Domain = types.StringValue(domainResponse.Data.Name)
+
+			return nil, false
+		}
+
+		var errorResponse *dnsimple.ErrorResponse
+		if !errors.As(err, &errorResponse) || errorResponse.HTTPResponse == nil || errorResponse.HTTPResponse.StatusCode != http.StatusNotFound {
+			diagnostics.AddError(
+				"failed to read DNSimple Domain",
+				fmt.Sprintf("Unable to read domain %s: %s", domainID, err.Error()),
+			)
+			return nil, true
+		}
+
+		if data.Id.IsNull() || data.Id.IsUnknown() {
+			pushes, err := common.ListAllPushes(ctx, r.config.Client, r.config.AccountID)
+			if err != nil {
+				diagnostics.AddError(
+					"failed to list DNSimple Domain Pushes",
+					err.Error(),
+				)
+				return nil, true
+			}
+
+			var push *dnsimple.DomainPush
+			for i := range pushes {
+				if pushes[i].DomainID == data.DomainId.ValueInt64() {
+					push = &pushes[i]
+					break
+				}
+			}
+
+			if push == nil {
+				tflog.Info(ctx, fmt.Sprintf("[RETRYING] No push of domain %s is pending", domainID))
+
+				return fmt.Errorf("no push of domain %s is pending. You can try to run terraform again to try and converge the domain push", domainID), false
+			}
+
+			_, err = r.config.Client.Domains.AcceptPush(ctx, r.config.AccountID, push.ID, dnsimple.DomainPushAttributes{
+				ContactID: data.ContactId.ValueInt64(),
+			})
+			if err != nil {
+				if errors.As(err, &errorResponse) {
+					diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+					return nil, true
+				}
+
+				diagnostics.AddError(
+					"failed to accept DNSimple Domain Push",
+					err.Error(),
+				)
+				return nil, true
+			}
+
+			data.Id = types.Int64Value(push.ID)
+			data.AccountId = types.Int64Value(push.AccountID)
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("[RETRYING] Domain %s is not in the account yet", domainID))
+
+		return fmt.Errorf("domain %s is not in the account yet. You can try to run terraform again to try and converge the domain push", domainID), false
+	}, timeout, domainPushPollInterval)
+
+	if diagnostics.HasError() {
+		// If we have diagnostic errors, we suspended the retry loop because the push cannot be accepted.
+		return DomainPushFailed, nil
+	}
+
+	if err != nil {
+		// If we have an error, it means the retry loop timed out, and we cannot converge during this run.
+		return DomainPushConvergenceTimeout, err
+	}
+
+	return DomainPushConverged, nil
+}
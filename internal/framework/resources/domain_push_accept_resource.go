@@ -0,0 +1,291 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &DomainPushAcceptResource{}
+	_ resource.ResourceWithConfigure  = &DomainPushAcceptResource{}
+	_ resource.ResourceWithModifyPlan = &DomainPushAcceptResource{}
+)
+
+func NewDomainPushAcceptResource() resource.Resource {
+	return &DomainPushAcceptResource{}
+}
+
+// DomainPushAcceptResource defines the resource implementation.
+type DomainPushAcceptResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// DomainPushAcceptResourceModel describes the resource data model.
+type DomainPushAcceptResourceModel struct {
+	Id        types.Int64    `tfsdk:"id"`
+	DomainId  types.Int64    `tfsdk:"domain_id"`
+	ContactId types.Int64    `tfsdk:"contact_id"`
+	Domain    types.String   `tfsdk:"domain"`
+	AccountId types.Int64    `tfsdk:"account_id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DomainPushAcceptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_push_accept"
+}
+
+func (r *DomainPushAcceptResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple domain push acceptance resource",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDInt64Attribute(),
+			"domain_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the pushed domain, as exported by `dnsimple_domain_push`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"contact_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the contact of the receiving account to assign the domain to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name of the domain, unset until the domain is in the account.",
+				Computed:            true,
+			},
+			"account_id": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *DomainPushAcceptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+// ModifyPlan plans a domain that is not in the account yet as unknown, so that
+// the update waits for it again.
+func (r *DomainPushAcceptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *DomainPushAcceptResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !state.Domain.IsNull() {
+		return
+	}
+
+	// The push of another domain is accepted instead.
+	if !plan.DomainId.Equal(state.DomainId) || !plan.ContactId.Equal(state.ContactId) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("domain"), types.StringUnknown())...)
+
+	// The push may still have to be accepted.
+	if state.Id.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.Int64Unknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("account_id"), types.Int64Unknown())...)
+	}
+}
+
+func (r *DomainPushAcceptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DomainPushAcceptResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, defaultDomainPushTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.Int64Unknown()
+
+	convergenceState, err := tryToConvergeDomainPushAcceptance(ctx, data, &resp.Diagnostics, r, timeout)
+	if convergenceState == DomainPushFailed {
+		// Response is already populated with the error we can safely return
+		return
+	}
+
+	domainPushAcceptSettleUnknowns(data)
+
+	if convergenceState == DomainPushConvergenceTimeout {
+		// Save data into Terraform state, the accepted push is kept so that
+		// the next run only waits for the domain
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		// Exit with warning to prevent the state from being tainted
+		resp.Diagnostics.AddWarning(
+			"failed to converge on domain push",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainPushAcceptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DomainPushAcceptResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.config.Client.Domains.GetDomain(ctx, r.config.AccountID, strconv.FormatInt(data.DomainId.ValueInt64(), 10))
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			// The domain did not arrive yet, the next apply waits for it.
+			if data.Domain.IsNull() {
+				return
+			}
+
+			tflog.Warn(ctx, "removing domain push acceptance from state because the domain is not present in the remote")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Domain",
+			fmt.Sprintf("Unable to read domain %d: %s", data.DomainId.ValueInt64(), err.Error()),
+		)
+		return
+	}
+
+	data.Domain = types.StringValue(response.Data.Name)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainPushAcceptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		planData  *DomainPushAcceptResourceModel
+		stateData *DomainPushAcceptResourceModel
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = stateData.Id
+	planData.AccountId = stateData.AccountId
+	planData.Domain = stateData.Domain
+
+	if planData.Domain.IsNull() {
+		timeout, diags := planData.Timeouts.Update(ctx, defaultDomainPushTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		convergenceState, err := tryToConvergeDomainPushAcceptance(ctx, planData, &resp.Diagnostics, r, timeout)
+		if convergenceState == DomainPushFailed {
+			// Response is already populated with the error we can safely return
+			return
+		}
+
+		if convergenceState == DomainPushConvergenceTimeout {
+			// Keep the push if it was accepted during this run
+			domainPushAcceptSettleUnknowns(planData)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+
+			// We attempted to converge on the domain push, but the domain is not in the account
+			// user needs to run terraform again to try and converge the domain push
+			resp.Diagnostics.AddError(
+				"failed to converge on domain push",
+				err.Error(),
+			)
+			return
+		}
+
+		domainPushAcceptSettleUnknowns(planData)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *DomainPushAcceptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op
+	tflog.Info(ctx, "an accepted domain push cannot be undone, the domain stays in the account")
+}
+
+// domainPushAcceptSettleUnknowns nulls the attributes the convergence could
+// not learn, such as the push ID when the push was accepted outside of
+// Terraform.
+func domainPushAcceptSettleUnknowns(data *DomainPushAcceptResourceModel) {
+	if data.Id.IsUnknown() {
+		data.Id = types.Int64Null()
+	}
+	if data.AccountId.IsUnknown() {
+		data.AccountId = types.Int64Null()
+	}
+	if data.Domain.IsUnknown() {
+		data.Domain = types.StringNull()
+	}
+}
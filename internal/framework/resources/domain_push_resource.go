@@ -0,0 +1,299 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &DomainPushResource{}
+	_ resource.ResourceWithConfigure  = &DomainPushResource{}
+	_ resource.ResourceWithModifyPlan = &DomainPushResource{}
+)
+
+func NewDomainPushResource() resource.Resource {
+	return &DomainPushResource{}
+}
+
+// DomainPushResource defines the resource implementation.
+type DomainPushResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// DomainPushResourceModel describes the resource data model.
+type DomainPushResourceModel struct {
+	Id              types.Int64    `tfsdk:"id"`
+	Domain          types.String   `tfsdk:"domain"`
+	NewAccountEmail types.String   `tfsdk:"new_account_email"`
+	DomainId        types.Int64    `tfsdk:"domain_id"`
+	AccountId       types.Int64    `tfsdk:"account_id"`
+	Accepted        types.Bool     `tfsdk:"accepted"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DomainPushResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_push"
+}
+
+func (r *DomainPushResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple domain push resource",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDInt64Attribute(),
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain to push.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.DomainName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"new_account_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the account to push the domain to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the domain, which the receiving account uses to accept the push.",
+				Computed:            true,
+			},
+			"account_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"accepted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the push was accepted, so that the domain has left the account.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *DomainPushResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+// ModifyPlan plans a push that was not accepted yet as accepted, so that the
+// update waits for it again.
+func (r *DomainPushResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *DomainPushResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.Accepted.ValueBool() {
+		return
+	}
+
+	// A new push is planned instead.
+	if !plan.Domain.Equal(state.Domain) || !plan.NewAccountEmail.Equal(state.NewAccountEmail) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("accepted"), true)...)
+}
+
+func (r *DomainPushResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DomainPushResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.config.Client.Domains.InitiatePush(ctx, r.config.AccountID, data.Domain.ValueString(), dnsimple.DomainPushAttributes{
+		NewAccountIdentifier: data.NewAccountEmail.ValueString(),
+	})
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to push DNSimple Domain",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.Int64Value(response.Data.ID)
+	data.DomainId = types.Int64Value(response.Data.DomainID)
+	data.AccountId = types.Int64Value(response.Data.AccountID)
+	data.Accepted = types.BoolValue(false)
+
+	timeout, diags := data.Timeouts.Create(ctx, defaultDomainPushTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var convergenceDiags diag.Diagnostics
+	convergenceState, err := tryToConvergeDomainPush(ctx, data, &convergenceDiags, r, timeout)
+	if convergenceState == DomainPushFailed {
+		// The push is initiated but could not be followed. Save it and exit
+		// with warnings, so that the state is not tainted and the push is not
+		// initiated again: the next run waits for it.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		for _, d := range convergenceDiags.Errors() {
+			resp.Diagnostics.AddWarning(d.Summary(), d.Detail())
+		}
+		return
+	}
+
+	if convergenceState == DomainPushConvergenceTimeout {
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		// Exit with warning to prevent the state from being tainted
+		resp.Diagnostics.AddWarning(
+			"failed to converge on domain push",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Accepted = types.BoolValue(true)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainPushResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DomainPushResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The push is kept in state once accepted, it records that the domain
+	// was handed over.
+	if data.Accepted.ValueBool() {
+		return
+	}
+
+	_, err := r.config.Client.Domains.GetDomain(ctx, r.config.AccountID, strconv.FormatInt(data.DomainId.ValueInt64(), 10))
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if !errors.As(err, &errorResponse) || errorResponse.HTTPResponse == nil || errorResponse.HTTPResponse.StatusCode != http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"failed to read DNSimple Domain",
+				fmt.Sprintf("Unable to read domain '%s': %s", data.Domain.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		data.Accepted = types.BoolValue(true)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainPushResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		planData  *DomainPushResourceModel
+		stateData *DomainPushResourceModel
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Accepted = stateData.Accepted
+
+	if !stateData.Accepted.ValueBool() {
+		timeout, diags := planData.Timeouts.Update(ctx, defaultDomainPushTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		convergenceState, err := tryToConvergeDomainPush(ctx, planData, &resp.Diagnostics, r, timeout)
+		if convergenceState == DomainPushFailed {
+			// Response is already populated with the error we can safely return
+			return
+		}
+
+		if convergenceState == DomainPushConvergenceTimeout {
+			// We attempted to converge on the domain push, but the push was not accepted
+			// user needs to run terraform again to try and converge the domain push
+			resp.Diagnostics.AddError(
+				"failed to converge on domain push",
+				err.Error(),
+			)
+			return
+		}
+
+		planData.Accepted = types.BoolValue(true)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *DomainPushResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op
+	tflog.Info(ctx, "domain pushes cannot be cancelled by the pushing account, removing the push from state")
+}
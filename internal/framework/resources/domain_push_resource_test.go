@@ -0,0 +1,88 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccDomainPushResource(t *testing.T) {
+	if test_utils.MockAPIEnabled() {
+		t.Skip("the mock API serves a single account, domain pushes are only tested against DNSimple")
+	}
+
+	// The domain is pushed to a second account, which accepts it through an
+	// aliased provider.
+	targetToken := os.Getenv("DNSIMPLE_PUSH_TARGET_TOKEN")
+	targetAccount := os.Getenv("DNSIMPLE_PUSH_TARGET_ACCOUNT")
+	targetEmail := os.Getenv("DNSIMPLE_PUSH_TARGET_EMAIL")
+	targetContactID := os.Getenv("DNSIMPLE_PUSH_TARGET_CONTACT_ID")
+	if targetToken == "" || targetAccount == "" || targetEmail == "" || targetContactID == "" {
+		t.Skip("DNSIMPLE_PUSH_TARGET_TOKEN, DNSIMPLE_PUSH_TARGET_ACCOUNT, DNSIMPLE_PUSH_TARGET_EMAIL and DNSIMPLE_PUSH_TARGET_CONTACT_ID must be set to test domain pushes")
+	}
+
+	domainName := "push-" + os.Getenv("DNSIMPLE_DOMAIN")
+	pushResourceName := "dnsimple_domain_push.test"
+	acceptResourceName := "dnsimple_domain_push_accept.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			test_utils.TestAccPreCheck(t)
+
+			// The domain is created outside of Terraform, as it leaves the
+			// account once the push is accepted.
+			if _, err := dnsimpleClient.Domains.CreateDomain(context.Background(), testAccAccount, dnsimple.Domain{Name: domainName}); err != nil {
+				t.Fatal(err)
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			// Clean up the domain from the receiving account.
+			targetClient := dnsimple.NewClient(dnsimple.StaticTokenHTTPClient(context.Background(), targetToken))
+			targetClient.BaseURL = dnsimpleClient.BaseURL
+			_, err := targetClient.Domains.DeleteDomain(context.Background(), targetAccount, domainName)
+			return err
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainPushResourceConfig(domainName, targetEmail, targetToken, targetAccount, targetContactID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(pushResourceName, "id"),
+					resource.TestCheckResourceAttr(pushResourceName, "domain", domainName),
+					resource.TestCheckResourceAttr(pushResourceName, "accepted", "true"),
+					resource.TestCheckResourceAttrPair(acceptResourceName, "domain_id", pushResourceName, "domain_id"),
+					resource.TestCheckResourceAttr(acceptResourceName, "domain", domainName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDomainPushResourceConfig(domainName, email, targetToken, targetAccount, contactID string) string {
+	return fmt.Sprintf(`
+provider "dnsimple" {
+	alias   = "target"
+	token   = %[3]q
+	account = %[4]q
+}
+
+resource "dnsimple_domain_push" "test" {
+	domain            = %[1]q
+	new_account_email = %[2]q
+}
+
+resource "dnsimple_domain_push_accept" "test" {
+	provider = dnsimple.target
+
+	domain_id  = dnsimple_domain_push.test.domain_id
+	contact_id = %[5]s
+}`, domainName, email, targetToken, targetAccount, contactID)
+}
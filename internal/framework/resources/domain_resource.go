@@ -20,9 +20,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &DomainResource{}
-	_ resource.ResourceWithConfigure   = &DomainResource{}
-	_ resource.ResourceWithImportState = &DomainResource{}
+	_ resource.Resource                 = &DomainResource{}
+	_ resource.ResourceWithConfigure    = &DomainResource{}
+	_ resource.ResourceWithImportState  = &DomainResource{}
+	_ resource.ResourceWithUpgradeState = &DomainResource{}
 )
 
 func NewDomainResource() resource.Resource {
@@ -56,6 +57,7 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "DNSimple domain resource",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Required: true,
@@ -150,6 +152,7 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	r.config.DomainCache.Upsert(r.config.AccountID, *response.Data)
 	r.updateModelFromAPIResponse(response.Data, data)
 
 	// Save data into Terraform state
@@ -166,16 +169,39 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	response, err := r.config.Client.Domains.GetDomain(ctx, r.config.AccountID, data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"failed to read DNSimple Domain",
-			fmt.Sprintf("Unable to read domain '%s': %s", data.Name.ValueString(), err.Error()),
-		)
-		return
+	var domain *dnsimple.Domain
+
+	if r.config.Prefetch {
+		err := r.config.DomainCache.Hydrate(ctx, r.config.AccountID, func(ctx context.Context) ([]dnsimple.Domain, error) {
+			return common.ListAllDomains(ctx, r.config.Client, r.config.AccountID)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to hydrate domain cache",
+				err.Error(),
+			)
+			return
+		}
+
+		if cached, ok := r.config.DomainCache.Get(r.config.AccountID, data.Name.ValueString()); ok {
+			domain = &cached
+		}
 	}
 
-	r.updateModelFromAPIResponse(response.Data, data)
+	if domain == nil {
+		response, err := r.config.Client.Domains.GetDomain(ctx, r.config.AccountID, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to read DNSimple Domain",
+				fmt.Sprintf("Unable to read domain '%s': %s", data.Name.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		domain = response.Data
+	}
+
+	r.updateModelFromAPIResponse(domain, data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -248,6 +274,8 @@ func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		)
 		return
 	}
+
+	r.config.DomainCache.Remove(r.config.AccountID, data.Name.ValueString())
 }
 
 func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -265,6 +293,46 @@ func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prevent_delete"), types.BoolValue(false))...)
 }
 
+func (r *DomainResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeDomainStateV0},
+	}
+}
+
+// upgradeDomainStateV0 upgrades the state written by the plugin SDK versions
+// of the resource, where the ID was a string, and by the provider versions
+// prior to prevent_delete and trustee.
+func upgradeDomainStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	prior, err := newRawState(req.RawState)
+	if err != nil {
+		resp.Diagnostics.Append(stateUpgradeErrorDiagnostics("dnsimple_domain", 0, err)...)
+		return
+	}
+
+	data := DomainResourceModel{
+		Name:          prior.String("name"),
+		PreventDelete: prior.Bool("prevent_delete"),
+		AccountId:     prior.Int64("account_id"),
+		RegistrantId:  prior.Int64("registrant_id"),
+		UnicodeName:   prior.String("unicode_name"),
+		State:         prior.String("state"),
+		AutoRenew:     prior.Bool("auto_renew"),
+		PrivateWhois:  prior.Bool("private_whois"),
+		Trustee:       prior.Bool("trustee"),
+		Id:            prior.Int64("id"),
+	}
+	if err := prior.Err(); err != nil {
+		resp.Diagnostics.Append(stateUpgradeErrorDiagnostics("dnsimple_domain", 0, err)...)
+		return
+	}
+
+	if data.PreventDelete.IsNull() {
+		data.PreventDelete = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
 func (r *DomainResource) updateModelFromAPIResponse(domain *dnsimple.Domain, data *DomainResourceModel) {
 	// prevent_delete is stored only in state and has no API counterpart, so state
 	// written before it existed carries no value for it. Settle it to the schema default
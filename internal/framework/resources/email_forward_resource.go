@@ -131,6 +131,7 @@ func (r *EmailForwardResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	r.config.EmailForwardCache.Upsert(common.DomainCacheScope(r.config.AccountID, data.Domain.ValueString()), *response.Data)
 	r.updateModelFromAPIResponse(response.Data, data)
 
 	tflog.Info(ctx, "created DNSimple EmailForward", map[string]interface{}{"id": data.Id.ValueInt64()})
@@ -149,16 +150,40 @@ func (r *EmailForwardResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	response, err := r.config.Client.Domains.GetEmailForward(ctx, r.config.AccountID, data.Domain.ValueString(), data.Id.ValueInt64())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"failed to read DNSimple Email Forward",
-			fmt.Sprintf("Unable to read email forward with ID %d: %s", data.Id.ValueInt64(), err.Error()),
-		)
-		return
+	var emailForward *dnsimple.EmailForward
+
+	if r.config.Prefetch {
+		scope := common.DomainCacheScope(r.config.AccountID, data.Domain.ValueString())
+		err := r.config.EmailForwardCache.Hydrate(ctx, scope, func(ctx context.Context) ([]dnsimple.EmailForward, error) {
+			return common.ListAllEmailForwards(ctx, r.config.Client, r.config.AccountID, data.Domain.ValueString())
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to hydrate email forward cache",
+				err.Error(),
+			)
+			return
+		}
+
+		if cached, ok := r.config.EmailForwardCache.Get(scope, strconv.FormatInt(data.Id.ValueInt64(), 10)); ok {
+			emailForward = &cached
+		}
 	}
 
-	r.updateModelFromAPIResponse(response.Data, data)
+	if emailForward == nil {
+		response, err := r.config.Client.Domains.GetEmailForward(ctx, r.config.AccountID, data.Domain.ValueString(), data.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to read DNSimple Email Forward",
+				fmt.Sprintf("Unable to read email forward with ID %d: %s", data.Id.ValueInt64(), err.Error()),
+			)
+			return
+		}
+
+		emailForward = response.Data
+	}
+
+	r.updateModelFromAPIResponse(emailForward, data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -189,6 +214,8 @@ func (r *EmailForwardResource) Delete(ctx context.Context, req resource.DeleteRe
 		)
 		return
 	}
+
+	r.config.EmailForwardCache.Remove(common.DomainCacheScope(r.config.AccountID, data.Domain.ValueString()), strconv.FormatInt(data.Id.ValueInt64(), 10))
 }
 
 func (r *EmailForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -2,9 +2,6 @@ package resources_test
 
 import (
 	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/provider"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
 )
 
@@ -13,9 +10,7 @@ var (
 	// acceptance testing. The factory function will be invoked for every Terraform
 	// CLI command executed to create a provider server to which the CLI can
 	// reattach.
-	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		"dnsimple": providerserver.NewProtocol6WithError(provider.New("test")()),
-	}
+	testAccProtoV6ProviderFactories = test_utils.TestAccProtoV6ProviderFactories()
 
 	// dnsimpleClient is the DNSimple client used to make API calls during
 	// acceptance testing.
@@ -27,6 +22,7 @@ var (
 
 func init() {
 	// If we are running acceptance tests TC_ACC then we initialize the DNSimple client
-	// with the credentials provided in the environment variables.
+	// with the credentials provided in the environment variables, or against the
+	// mock API when DNSIMPLE_MOCK_API is set.
 	dnsimpleClient, testAccAccount = test_utils.LoadDNSimpleTestClient()
 }
@@ -0,0 +1,554 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/validators"
+)
+
+// primaryServerPort is the port DNSimple transfers secondary zones on.
+const primaryServerPort = 53
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &SecondaryZoneResource{}
+	_ resource.ResourceWithConfigure   = &SecondaryZoneResource{}
+	_ resource.ResourceWithImportState = &SecondaryZoneResource{}
+	_ resource.ResourceWithModifyPlan  = &SecondaryZoneResource{}
+)
+
+func NewSecondaryZoneResource() resource.Resource {
+	return &SecondaryZoneResource{}
+}
+
+// SecondaryZoneResource defines the resource implementation.
+type SecondaryZoneResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// SecondaryZoneResourceModel describes the resource data model.
+type SecondaryZoneResourceModel struct {
+	Id                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	AccountId         types.Int64  `tfsdk:"account_id"`
+	PrimaryServers    types.Set    `tfsdk:"primary_servers"`
+	Active            types.Bool   `tfsdk:"active"`
+	Transferred       types.Bool   `tfsdk:"transferred"`
+	LastTransferredAt types.String `tfsdk:"last_transferred_at"`
+	PreventDelete     types.Bool   `tfsdk:"prevent_delete"`
+}
+
+func (r *SecondaryZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secondary_zone"
+}
+
+func (r *SecondaryZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple secondary zone resource",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDInt64Attribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"primary_servers": schema.SetAttribute{
+				MarkdownDescription: "The public IP addresses of the primary name servers the zone is transferred from, on port 53.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					validators.PublicIPAddresses{},
+				},
+			},
+			"active": schema.BoolAttribute{
+				Computed: true,
+			},
+			"transferred": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone has been transferred from a primary server at least once.",
+				Computed:            true,
+			},
+			"last_transferred_at": schema.StringAttribute{
+				MarkdownDescription: "When the zone was last transferred from a primary server.",
+				Computed:            true,
+			},
+			"prevent_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether to block `terraform destroy` from deleting the zone. Defaults to `false`. When set to `true`, destroying this resource fails until it is set back to `false` and applied.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *SecondaryZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+// ModifyPlan warns when a destroy is planned for a zone protected from
+// deletion, as the apply is going to fail.
+func (r *SecondaryZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only destroy plans of existing zones are checked.
+	if req.State.Raw.IsNull() || !req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *SecondaryZoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.PreventDelete.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"the secondary zone is protected from deletion.",
+		fmt.Sprintf("Destroying this resource fails while prevent_delete is true. Set prevent_delete to false and apply before destroying the secondary zone %s.", data.Name.ValueString()),
+	)
+}
+
+func (r *SecondaryZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecondaryZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := common.CreateSecondaryZone(ctx, r.config.Client, r.config.AccountID, data.Name.ValueString())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to create DNSimple Secondary Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(zone, data)
+
+	// The zone exists from here on, so it is saved even if linking the
+	// primary servers fails, which taints the resource.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.linkPrimaryServers(ctx, data, "failed to create DNSimple Secondary Zone")...)
+}
+
+func (r *SecondaryZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SecondaryZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.config.Client.Zones.GetZone(ctx, r.config.AccountID, data.Name.ValueString())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, "removing secondary zone from state because it is not present in the remote")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Secondary Zone",
+			fmt.Sprintf("Unable to read secondary zone '%s': %s", data.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if !response.Data.Secondary {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"failed to read DNSimple Secondary Zone",
+			fmt.Sprintf("Zone '%s' is not a secondary zone. Manage it with the dnsimple_zone resource instead.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(response.Data, data)
+
+	servers, err := common.ListAllPrimaryServers(ctx, r.config.Client, r.config.AccountID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Secondary Zone",
+			fmt.Sprintf("Unable to list the primary servers of secondary zone '%s': %s", data.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.updatePrimaryServersFromAPIResponse(ctx, servers, data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecondaryZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data      *SecondaryZoneResourceModel
+		stateData *SecondaryZoneResourceModel
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only warn on an actual true -> false transition.
+	if stateData.PreventDelete.ValueBool() && !data.PreventDelete.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("prevent_delete"),
+			"the secondary zone is no longer protected from deletion.",
+			fmt.Sprintf("Destroying this resource will now DELETE the secondary zone %s and the primary servers created for it.", data.Name.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(r.linkPrimaryServers(ctx, data, "failed to update DNSimple Secondary Zone")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.config.Client.Zones.GetZone(ctx, r.config.AccountID, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Secondary Zone",
+			fmt.Sprintf("Unable to read secondary zone '%s': %s", data.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(response.Data, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecondaryZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecondaryZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PreventDelete.ValueBool() {
+		resp.Diagnostics.AddError(
+			"failed to delete DNSimple Secondary Zone",
+			"Secondary zone deletion protection enabled.",
+		)
+		resp.Diagnostics.AddWarning(
+			"DNSimple stops serving the zone when deleting secondary zone resources.",
+			fmt.Sprintf("Disabling deletion protection and destroying this resource will DELETE the secondary zone %s and the primary servers created for it. Note this also blocks the destroy half of a replacement, so changing 'name' fails until protection is disabled.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting DNSimple Secondary Zone: %s, %s", data.Name, data.Id))
+
+	// The zone is deleted along with the domain backing it. The primary
+	// servers are left as they are until it is gone, so that a failed
+	// deletion keeps the zone transferring.
+	_, err := r.config.Client.Domains.DeleteDomain(ctx, r.config.AccountID, data.Name.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"failed to delete DNSimple Secondary Zone",
+			fmt.Sprintf("Unable to delete secondary zone '%s': %s", data.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	r.config.DomainCache.Remove(r.config.AccountID, data.Name.ValueString())
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, data.Name.ValueString())
+
+	// A failure leaves the resource in state, so that the next destroy tries
+	// again.
+	resp.Diagnostics.Append(r.removePrimaryServers(ctx, data.Name.ValueString(), "failed to delete DNSimple Secondary Zone")...)
+}
+
+func (r *SecondaryZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+func (r *SecondaryZoneResource) updateModelFromAPIResponse(zone *dnsimple.Zone, data *SecondaryZoneResourceModel) {
+	// prevent_delete is stored only in state. Imported zones are not
+	// protected.
+	if data.PreventDelete.IsNull() || data.PreventDelete.IsUnknown() {
+		data.PreventDelete = types.BoolValue(false)
+	}
+
+	data.Id = types.Int64Value(zone.ID)
+	data.Name = types.StringValue(zone.Name)
+	data.AccountId = types.Int64Value(zone.AccountID)
+	data.Active = types.BoolValue(zone.Active)
+	data.Transferred = types.BoolValue(zone.LastTransferredAt != "")
+	data.LastTransferredAt = types.StringValue(zone.LastTransferredAt)
+}
+
+// updatePrimaryServersFromAPIResponse sets the addresses of the primary
+// servers linked to the zone. An address written differently in the prior
+// state, such as an IPv6 address in upper case, keeps its spelling.
+func (r *SecondaryZoneResource) updatePrimaryServersFromAPIResponse(ctx context.Context, servers []common.PrimaryServer, data *SecondaryZoneResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	var prior []string
+	if !data.PrimaryServers.IsNull() && !data.PrimaryServers.IsUnknown() {
+		diagnostics.Append(data.PrimaryServers.ElementsAs(ctx, &prior, false)...)
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+	}
+
+	addresses := []string{}
+	for _, server := range linkedPrimaryServers(servers, data.Name.ValueString()) {
+		address := server.IP
+		for _, priorAddress := range prior {
+			if sameIPAddress(priorAddress, server.IP) {
+				address = priorAddress
+				break
+			}
+		}
+
+		if !slices.Contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.PrimaryServers, diags = types.SetValueFrom(ctx, types.StringType, addresses)
+	diagnostics.Append(diags...)
+
+	return diagnostics
+}
+
+// linkPrimaryServers links the primary servers with the planned addresses to
+// the zone, and unlinks the others. Primary servers are shared across the
+// account: an existing server with the address is reused, and a server is
+// only deleted when it was created for the zone and no zone is linked to it
+// anymore.
+func (r *SecondaryZoneResource) linkPrimaryServers(ctx context.Context, data *SecondaryZoneResourceModel, summary string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	zoneName := data.Name.ValueString()
+
+	var addresses []string
+	diagnostics.Append(data.PrimaryServers.ElementsAs(ctx, &addresses, false)...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	servers, err := common.ListAllPrimaryServers(ctx, r.config.Client, r.config.AccountID)
+	if err != nil {
+		diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Unable to list the primary servers: %s", err.Error()),
+		)
+		return diagnostics
+	}
+
+	for _, server := range linkedPrimaryServers(servers, zoneName) {
+		if slices.ContainsFunc(addresses, func(address string) bool { return sameIPAddress(address, server.IP) }) {
+			continue
+		}
+
+		tflog.Debug(ctx, "unlinking primary server", map[string]interface{}{"zone": zoneName, "ip": server.IP})
+
+		unlinked, err := common.UnlinkPrimaryServer(ctx, r.config.Client, r.config.AccountID, server.ID, zoneName)
+		if err != nil {
+			diagnostics.Append(primaryServerErrorDiagnostics(summary, fmt.Sprintf("Unable to unlink primary server '%s'", server.IP), err)...)
+			return diagnostics
+		}
+
+		if len(unlinked.LinkedSecondaryZones) > 0 || unlinked.Name != primaryServerName(zoneName, server.IP) {
+			continue
+		}
+
+		if err := common.DeletePrimaryServer(ctx, r.config.Client, r.config.AccountID, server.ID); err != nil {
+			diagnostics.Append(primaryServerErrorDiagnostics(summary, fmt.Sprintf("Unable to delete primary server '%s'", server.IP), err)...)
+			return diagnostics
+		}
+	}
+
+	for _, address := range addresses {
+		index := slices.IndexFunc(servers, func(server common.PrimaryServer) bool {
+			return server.Port == primaryServerPort && sameIPAddress(address, server.IP)
+		})
+
+		var server *common.PrimaryServer
+		if index >= 0 {
+			server = &servers[index]
+		} else {
+			tflog.Debug(ctx, "creating primary server", map[string]interface{}{"zone": zoneName, "ip": address})
+
+			server, err = common.CreatePrimaryServer(ctx, r.config.Client, r.config.AccountID, primaryServerName(zoneName, address), address, primaryServerPort)
+			if err != nil {
+				diagnostics.Append(primaryServerErrorDiagnostics(summary, fmt.Sprintf("Unable to create primary server '%s'", address), err)...)
+				return diagnostics
+			}
+		}
+
+		if slices.Contains(server.LinkedSecondaryZones, zoneName) {
+			continue
+		}
+
+		tflog.Debug(ctx, "linking primary server", map[string]interface{}{"zone": zoneName, "ip": address})
+
+		if _, err := common.LinkPrimaryServer(ctx, r.config.Client, r.config.AccountID, server.ID, zoneName); err != nil {
+			diagnostics.Append(primaryServerErrorDiagnostics(summary, fmt.Sprintf("Unable to link primary server '%s'", address), err)...)
+			return diagnostics
+		}
+	}
+
+	return diagnostics
+}
+
+// removePrimaryServers unlinks the primary servers still linked to a deleted
+// zone, and deletes the ones created for it that no zone is linked to
+// anymore.
+func (r *SecondaryZoneResource) removePrimaryServers(ctx context.Context, zoneName string, summary string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	servers, err := common.ListAllPrimaryServers(ctx, r.config.Client, r.config.AccountID)
+	if err != nil {
+		diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Unable to list the primary servers: %s", err.Error()),
+		)
+		return diagnostics
+	}
+
+	for _, server := range servers {
+		linkedZones := server.LinkedSecondaryZones
+
+		if slices.Contains(linkedZones, zoneName) {
+			tflog.Debug(ctx, "unlinking primary server", map[string]interface{}{"zone": zoneName, "ip": server.IP})
+
+			unlinked, err := common.UnlinkPrimaryServer(ctx, r.config.Client, r.config.AccountID, server.ID, zoneName)
+			switch {
+			case err == nil:
+				linkedZones = unlinked.LinkedSecondaryZones
+			case isNotFoundError(err):
+				// The link went away with the zone.
+				linkedZones = slices.DeleteFunc(slices.Clone(linkedZones), func(name string) bool { return name == zoneName })
+			default:
+				diagnostics.Append(primaryServerErrorDiagnostics(summary, fmt.Sprintf("Unable to unlink primary server '%s'", server.IP), err)...)
+				return diagnostics
+			}
+		}
+
+		if len(linkedZones) > 0 || server.Name != primaryServerName(zoneName, server.IP) {
+			continue
+		}
+
+		if err := common.DeletePrimaryServer(ctx, r.config.Client, r.config.AccountID, server.ID); err != nil && !isNotFoundError(err) {
+			diagnostics.Append(primaryServerErrorDiagnostics(summary, fmt.Sprintf("Unable to delete primary server '%s'", server.IP), err)...)
+			return diagnostics
+		}
+	}
+
+	return diagnostics
+}
+
+// isNotFoundError reports whether err is a 404 response of the API.
+func isNotFoundError(err error) bool {
+	var errorResponse *dnsimple.ErrorResponse
+	return errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound
+}
+
+func primaryServerErrorDiagnostics(summary string, detail string, err error) diag.Diagnostics {
+	var errorResponse *dnsimple.ErrorResponse
+	if errors.As(err, &errorResponse) {
+		return utils.AttributeErrorsToDiagnostics(errorResponse)
+	}
+
+	diagnostics := diag.Diagnostics{}
+	diagnostics.AddError(summary, fmt.Sprintf("%s: %s", detail, err.Error()))
+	return diagnostics
+}
+
+// linkedPrimaryServers returns the primary servers linked to the zone.
+func linkedPrimaryServers(servers []common.PrimaryServer, zoneName string) []common.PrimaryServer {
+	var linked []common.PrimaryServer
+	for _, server := range servers {
+		if slices.Contains(server.LinkedSecondaryZones, zoneName) {
+			linked = append(linked, server)
+		}
+	}
+
+	return linked
+}
+
+// primaryServerName is the name of the primary servers created for a zone.
+func primaryServerName(zoneName string, address string) string {
+	if parsed, err := netip.ParseAddr(address); err == nil {
+		address = parsed.String()
+	}
+
+	return fmt.Sprintf("%s %s", zoneName, address)
+}
+
+// sameIPAddress reports whether a and b are the same IP address, however
+// they are written.
+func sameIPAddress(a string, b string) bool {
+	addressA, errA := netip.ParseAddr(a)
+	addressB, errB := netip.ParseAddr(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+
+	return addressA == addressB
+}
@@ -0,0 +1,146 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+func TestAccSecondaryZoneResource(t *testing.T) {
+	zoneName := utils.RandomName("com", "secondary")
+	resourceName := "dnsimple_secondary_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSecondaryZoneResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecondaryZoneResourceConfig(zoneName, `["203.0.113.10", "2001:DB8::10"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", zoneName),
+					resource.TestCheckResourceAttr(resourceName, "primary_servers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "primary_servers.*", "2001:DB8::10"),
+					resource.TestCheckResourceAttr(resourceName, "transferred", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           zoneName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"primary_servers"},
+			},
+			{
+				Config: testAccSecondaryZoneResourceConfig(zoneName, `["203.0.113.11"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "primary_servers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "primary_servers.*", "203.0.113.11"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSecondaryZoneResource_PreventDelete(t *testing.T) {
+	zoneName := utils.RandomName("com", "secondary")
+	resourceName := "dnsimple_secondary_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSecondaryZoneResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecondaryZoneResourcePreventDeleteConfig(zoneName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prevent_delete", "true"),
+				),
+			},
+			{
+				Config:      testAccSecondaryZoneResourcePreventDeleteConfig(zoneName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Secondary zone deletion protection enabled"),
+			},
+			{
+				// The primary servers are still linked after the refused destroy.
+				Config: testAccSecondaryZoneResourcePreventDeleteConfig(zoneName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prevent_delete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "primary_servers.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSecondaryZoneResource_InvalidPrimaryServer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSecondaryZoneResourceConfig(utils.RandomName("com", "secondary"), `["10.0.0.1"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid IP address`),
+			},
+		},
+	})
+}
+
+// Destroying the resource deletes the zone and the primary servers created
+// for it.
+func testAccCheckSecondaryZoneResourceDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "dnsimple_secondary_zone" {
+			continue
+		}
+
+		zoneName := rs.Primary.Attributes["name"]
+
+		_, err := dnsimpleClient.Zones.GetZone(context.Background(), testAccAccount, zoneName)
+		if err == nil {
+			return fmt.Errorf("secondary zone %s still exists", zoneName)
+		}
+
+		servers, err := common.ListAllPrimaryServers(context.Background(), dnsimpleClient, testAccAccount)
+		if err != nil {
+			return err
+		}
+		for _, server := range servers {
+			if slices.Contains(server.LinkedSecondaryZones, zoneName) {
+				return fmt.Errorf("primary server %s is still linked to %s", server.IP, zoneName)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccSecondaryZoneResourceConfig(zoneName string, primaryServers string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_secondary_zone" "test" {
+	name            = %[1]q
+	primary_servers = %[2]s
+}`, zoneName, primaryServers)
+}
+
+func testAccSecondaryZoneResourcePreventDeleteConfig(zoneName string, preventDelete bool) string {
+	return fmt.Sprintf(`
+resource "dnsimple_secondary_zone" "test" {
+	name            = %[1]q
+	primary_servers = ["203.0.113.10"]
+	prevent_delete  = %[2]t
+}`, zoneName, preventDelete)
+}
@@ -0,0 +1,303 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ServiceResource{}
+	_ resource.ResourceWithConfigure   = &ServiceResource{}
+	_ resource.ResourceWithImportState = &ServiceResource{}
+)
+
+func NewServiceResource() resource.Resource {
+	return &ServiceResource{}
+}
+
+// ServiceResource defines the resource implementation.
+type ServiceResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// ServiceResourceModel describes the resource data model.
+type ServiceResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Domain    types.String `tfsdk:"domain"`
+	Service   types.String `tfsdk:"service"`
+	Settings  types.Map    `tfsdk:"settings"`
+	ServiceId types.Int64  `tfsdk:"service_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+func (r *ServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (r *ServiceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple one-click service resource",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDStringAttribute(),
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain to apply the service to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "The SID or the ID of the service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "The settings the service requires, as listed by the `dnsimple_services` data source.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					// The settings cannot be read back, an imported service
+					// takes the configured ones without being applied again.
+					mapplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+						value, diags := req.Private.GetKey(ctx, "imported_settings")
+						resp.Diagnostics.Append(diags...)
+						resp.RequiresReplace = string(value) != "true"
+					}, "Changing the settings applies the service again, unless the service was imported.", "Changing the settings applies the service again, unless the service was imported."),
+				},
+			},
+			"service_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the service.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := map[string]string{}
+	if !data.Settings.IsNull() {
+		resp.Diagnostics.Append(data.Settings.ElementsAs(ctx, &settings, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := common.ApplyService(ctx, r.config.Client, r.config.AccountID, data.Service.ValueString(), data.Domain.ValueString(), settings)
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to apply DNSimple Service",
+			err.Error(),
+		)
+		return
+	}
+
+	// Applying a service creates zone records.
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, data.Domain.ValueString())
+
+	service, err := r.findAppliedService(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Service",
+			fmt.Sprintf("Unable to read service '%s' applied to domain '%s': %s", data.Service.ValueString(), data.Domain.ValueString(), err.Error()),
+		)
+		return
+	}
+	if service == nil {
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Service",
+			fmt.Sprintf("Service '%s' is not listed among the services applied to domain '%s'", data.Service.ValueString(), data.Domain.ValueString()),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(service, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.findAppliedService(ctx, data)
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, "removing service from state because the domain is not present in the remote")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Service",
+			fmt.Sprintf("Unable to read service '%s' applied to domain '%s': %s", data.Service.ValueString(), data.Domain.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if service == nil {
+		tflog.Warn(ctx, "removing service from state because it is not applied in the remote")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.updateModelFromAPIResponse(service, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ServiceResourceModel
+
+	// Only the settings of an imported service can change in place, they are
+	// taken as configured.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Later changes of the settings apply the service again.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "imported_settings", nil)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Unapplying DNSimple Service: %s, %s", data.Domain, data.Service))
+
+	_, err := r.config.Client.Services.UnapplyService(ctx, r.config.AccountID, data.Service.ValueString(), data.Domain.ValueString())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if !errors.As(err, &errorResponse) || errorResponse.HTTPResponse == nil || errorResponse.HTTPResponse.StatusCode != http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"failed to unapply DNSimple Service",
+				fmt.Sprintf("Unable to unapply service '%s' from domain '%s': %s", data.Service.ValueString(), data.Domain.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, data.Domain.ValueString())
+}
+
+// ImportState accepts an ID given as <domain>_<service>, where the service is
+// given by SID or ID.
+func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	domain, service, ok := strings.Cut(req.ID, "_")
+	if !ok || domain == "" || service == "" {
+		resp.Diagnostics.AddError(
+			"invalid import ID",
+			fmt.Sprintf("Invalid import ID format '%s'. Expected format: '<domain>_<service>'", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), service)...)
+
+	// The settings of the imported service are unknown, the configured ones
+	// are taken as is by the next apply.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "imported_settings", []byte(`true`))...)
+}
+
+// findAppliedService returns the service among those applied to the domain,
+// or nil when it is not applied.
+func (r *ServiceResource) findAppliedService(ctx context.Context, data *ServiceResourceModel) (*dnsimple.Service, error) {
+	services, err := common.ListAllAppliedServices(ctx, r.config.Client, r.config.AccountID, data.Domain.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range services {
+		if service.SID == data.Service.ValueString() || strconv.FormatInt(service.ID, 10) == data.Service.ValueString() {
+			return &service, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *ServiceResource) updateModelFromAPIResponse(service *dnsimple.Service, data *ServiceResourceModel) {
+	data.Id = types.StringValue(fmt.Sprintf("%s_%s", data.Domain.ValueString(), data.Service.ValueString()))
+	data.ServiceId = types.Int64Value(service.ID)
+	data.Name = types.StringValue(service.Name)
+}
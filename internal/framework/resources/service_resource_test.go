@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccServiceResource(t *testing.T) {
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+	resourceName := "dnsimple_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServiceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceResourceConfig(domainName, "terraform-provider-dnsimple"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", domainName+"_heroku"),
+					resource.TestCheckResourceAttr(resourceName, "service", "heroku"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Heroku"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           domainName + "_heroku",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+			{
+				// Changing the settings applies the service again.
+				Config: testAccServiceResourceConfig(domainName, "terraform-provider-dnsimple-v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckServiceResourceDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "dnsimple_service" {
+			continue
+		}
+
+		services, err := common.ListAllAppliedServices(context.Background(), dnsimpleClient, testAccAccount, rs.Primary.Attributes["domain"])
+		if err != nil {
+			return err
+		}
+		for _, service := range services {
+			if service.SID == rs.Primary.Attributes["service"] {
+				return fmt.Errorf("service is still applied")
+			}
+		}
+	}
+	return nil
+}
+
+func testAccServiceResourceConfig(domainName, app string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_service" "test" {
+	domain  = %[1]q
+	service = "heroku"
+
+	settings = {
+		app = %[2]q
+	}
+}`, domainName, app)
+}
@@ -0,0 +1,174 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// rawState holds the attributes of a state written by a prior schema
+// version.
+//
+// The prior versions of a resource do not share a single layout: the
+// resources built on the plugin SDK stored IDs and some numbers as strings,
+// and attributes were added over time. The upgraders therefore read the raw
+// JSON state rather than a prior schema, and convert the values leniently.
+// The first value that cannot be converted is reported by Err.
+type rawState struct {
+	attributes map[string]any
+	err        error
+}
+
+func newRawState(raw *tfprotov6.RawState) (*rawState, error) {
+	if raw == nil || raw.JSON == nil {
+		return nil, errors.New("the prior state is not in the JSON format, refresh it with Terraform 0.12 or later first")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw.JSON))
+	decoder.UseNumber()
+
+	state := &rawState{}
+	if err := decoder.Decode(&state.attributes); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Err returns the first attribute that could not be converted.
+func (s *rawState) Err() error {
+	return s.err
+}
+
+func (s *rawState) fail(name string, err error) {
+	if s.err == nil {
+		s.err = fmt.Errorf("attribute %s: %w", name, err)
+	}
+}
+
+// Rename moves an attribute, for a prior state that named it differently.
+func (s *rawState) Rename(from string, to string) {
+	if value, ok := s.attributes[from]; ok {
+		s.attributes[to] = value
+		delete(s.attributes, from)
+	}
+}
+
+// Has reports whether the attribute is set.
+func (s *rawState) Has(name string) bool {
+	return s.attributes[name] != nil
+}
+
+// String returns the attribute as a string. Missing attributes are null.
+func (s *rawState) String(name string) types.String {
+	switch value := s.attributes[name].(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(value)
+	case json.Number:
+		return types.StringValue(value.String())
+	case bool:
+		return types.StringValue(strconv.FormatBool(value))
+	default:
+		s.fail(name, fmt.Errorf("unexpected %T", value))
+		return types.StringNull()
+	}
+}
+
+// Int64 returns the attribute as an integer, which the plugin SDK may have
+// stored as a string. Missing attributes and empty strings are null.
+func (s *rawState) Int64(name string) types.Int64 {
+	var (
+		number int64
+		err    error
+	)
+
+	switch value := s.attributes[name].(type) {
+	case nil:
+		return types.Int64Null()
+	case json.Number:
+		number, err = value.Int64()
+	case string:
+		if value == "" {
+			return types.Int64Null()
+		}
+		number, err = strconv.ParseInt(value, 10, 64)
+	default:
+		err = fmt.Errorf("unexpected %T", value)
+	}
+
+	if err != nil {
+		s.fail(name, err)
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(number)
+}
+
+// Bool returns the attribute as a boolean, which the plugin SDK may have
+// stored as a string. Missing attributes and empty strings are null.
+func (s *rawState) Bool(name string) types.Bool {
+	switch value := s.attributes[name].(type) {
+	case nil:
+		return types.BoolNull()
+	case bool:
+		return types.BoolValue(value)
+	case string:
+		if value == "" {
+			return types.BoolNull()
+		}
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			s.fail(name, err)
+			return types.BoolNull()
+		}
+		return types.BoolValue(boolean)
+	default:
+		s.fail(name, fmt.Errorf("unexpected %T", value))
+		return types.BoolNull()
+	}
+}
+
+// StringList returns the attribute as a list of strings. Missing attributes
+// are null.
+func (s *rawState) StringList(name string) types.List {
+	if s.attributes[name] == nil {
+		return types.ListNull(types.StringType)
+	}
+
+	values, ok := s.attributes[name].([]any)
+	if !ok {
+		s.fail(name, fmt.Errorf("unexpected %T", s.attributes[name]))
+		return types.ListNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		element, ok := value.(string)
+		if !ok {
+			s.fail(name, fmt.Errorf("unexpected %T element", value))
+			return types.ListNull(types.StringType)
+		}
+		elements = append(elements, types.StringValue(element))
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// stateUpgradeErrorDiagnostics reports a prior state that cannot be read.
+func stateUpgradeErrorDiagnostics(resourceType string, version int64, err error) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	diagnostics.AddError(
+		"Unable to Upgrade Resource State",
+		fmt.Sprintf("Unable to upgrade the %s state from schema version %d: %s", resourceType, version, err.Error()),
+	)
+	return diagnostics
+}
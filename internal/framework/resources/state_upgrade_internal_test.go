@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type upgradableResource interface {
+	resource.Resource
+	resource.ResourceWithUpgradeState
+}
+
+// upgradeStateFixture upgrades the prior state in testdata/state_upgrade to
+// the current schema of the resource.
+func upgradeStateFixture(t *testing.T, r upgradableResource, version int64, fixture string) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	raw, err := os.ReadFile(filepath.Join("testdata", "state_upgrade", fixture))
+	require.NoError(t, err)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	require.True(t, ok, "no upgrader for schema version %d", version)
+
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: raw}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	return resp.State
+}
+
+func TestZoneRecordResource_UpgradeState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("plugin SDK layout", func(t *testing.T) {
+		state := upgradeStateFixture(t, &ZoneRecordResource{}, 0, "zone_record_v0_sdk.json")
+
+		var data ZoneRecordResourceModel
+		require.False(t, state.Get(ctx, &data).HasError())
+
+		assert.Equal(t, types.Int64Value(1234567), data.Id)
+		assert.Equal(t, types.StringValue("example.com"), data.ZoneName)
+		assert.Equal(t, types.StringValue("www"), data.Name)
+		assert.Equal(t, types.StringValue("www"), data.NameNormalized)
+		assert.Equal(t, types.StringValue("www.example.com"), data.QualifiedName)
+		assert.Equal(t, types.StringValue("192.0.2.1"), data.Value)
+		assert.Equal(t, types.StringValue("192.0.2.1"), data.ValueNormalized)
+		assert.Equal(t, types.Int64Value(3600), data.TTL)
+		assert.True(t, data.Priority.IsNull())
+		assert.True(t, data.Regions.IsNull())
+		assert.True(t, data.TXTStrings.IsNull())
+		assert.Equal(t, types.BoolValue(false), data.WaitForDistribution)
+	})
+
+	t.Run("framework layout", func(t *testing.T) {
+		state := upgradeStateFixture(t, &ZoneRecordResource{}, 0, "zone_record_v0.json")
+
+		var data ZoneRecordResourceModel
+		require.False(t, state.Get(ctx, &data).HasError())
+
+		assert.Equal(t, types.Int64Value(1234567), data.Id)
+		assert.Equal(t, types.StringValue(""), data.Name)
+		assert.Equal(t, types.StringValue(""), data.NameNormalized)
+		assert.Equal(t, types.StringValue("MX"), data.Type)
+		assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("global")}), data.Regions)
+		assert.Equal(t, types.StringValue("mx.example.com"), data.ValueNormalized)
+		assert.Equal(t, types.Int64Value(600), data.TTL)
+		assert.Equal(t, types.Int64Value(10), data.Priority)
+	})
+}
+
+func TestDomainResource_UpgradeState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("plugin SDK layout", func(t *testing.T) {
+		state := upgradeStateFixture(t, &DomainResource{}, 0, "domain_v0_sdk.json")
+
+		var data DomainResourceModel
+		require.False(t, state.Get(ctx, &data).HasError())
+
+		assert.Equal(t, types.Int64Value(98765), data.Id)
+		assert.Equal(t, types.StringValue("example.com"), data.Name)
+		assert.Equal(t, types.Int64Value(1010), data.AccountId)
+		assert.Equal(t, types.StringValue("hosted"), data.State)
+		assert.Equal(t, types.BoolValue(false), data.PreventDelete)
+		assert.True(t, data.Trustee.IsNull())
+	})
+
+	t.Run("framework layout", func(t *testing.T) {
+		state := upgradeStateFixture(t, &DomainResource{}, 0, "domain_v0.json")
+
+		var data DomainResourceModel
+		require.False(t, state.Get(ctx, &data).HasError())
+
+		assert.Equal(t, types.Int64Value(98765), data.Id)
+		assert.Equal(t, types.Int64Value(4321), data.RegistrantId)
+		assert.Equal(t, types.BoolValue(true), data.PreventDelete)
+		assert.Equal(t, types.BoolValue(true), data.AutoRenew)
+		assert.Equal(t, types.BoolValue(false), data.Trustee)
+	})
+}
+
+func TestContactResource_UpgradeState(t *testing.T) {
+	ctx := context.Background()
+
+	state := upgradeStateFixture(t, &ContactResource{}, 0, "contact_v0.json")
+
+	var data ContactResourceModel
+	require.False(t, state.Get(ctx, &data).HasError())
+
+	assert.Equal(t, types.Int64Value(5555), data.Id)
+	assert.Equal(t, types.StringValue("Main"), data.Label)
+	assert.Equal(t, types.StringValue("+1 401 555 0100"), data.Phone)
+	assert.Equal(t, types.StringValue("+1 401 555 0100"), data.PhoneNormalized)
+	assert.Equal(t, types.StringValue(""), data.Fax)
+	assert.Equal(t, types.StringValue(""), data.FaxNormalized)
+}
+
+func TestRawState_InvalidValues(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		json string
+	}{
+		{name: "non numeric ID", json: `{"id": "abc"}`},
+		{name: "non boolean flag", json: `{"id": 1, "auto_renew": "maybe"}`},
+		{name: "object as a string", json: `{"id": 1, "name": {}}`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.UpgradeStateResponse{}
+			upgradeDomainStateV0(context.Background(), resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(tt.json)}}, resp)
+
+			if assert.True(t, resp.Diagnostics.HasError()) {
+				assert.Equal(t, "Unable to Upgrade Resource State", resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+
+	resp := &resource.UpgradeStateResponse{}
+	upgradeDomainStateV0(context.Background(), resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{Flatmap: map[string]string{"id": "1"}}}, resp)
+	assert.True(t, resp.Diagnostics.HasError(), "flatmap state is not supported")
+}
+
+func TestZoneRecordResource_MoveState(t *testing.T) {
+	ctx := context.Background()
+
+	raw, err := os.ReadFile(filepath.Join("testdata", "state_upgrade", "record_sdk.json"))
+	require.NoError(t, err)
+
+	schemaResp := &resource.SchemaResponse{}
+	(&ZoneRecordResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	move := func(sourceType string, sourceProvider string) *resource.MoveStateResponse {
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		moveZoneRecordStateFromRecord(ctx, resource.MoveStateRequest{
+			SourceTypeName:        sourceType,
+			SourceProviderAddress: sourceProvider,
+			SourceRawState:        &tfprotov6.RawState{JSON: raw},
+		}, resp)
+		return resp
+	}
+
+	t.Run("dnsimple_record", func(t *testing.T) {
+		resp := move("dnsimple_record", "registry.terraform.io/dnsimple/dnsimple")
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data ZoneRecordResourceModel
+		require.False(t, resp.TargetState.Get(ctx, &data).HasError())
+
+		assert.Equal(t, types.Int64Value(2879253), data.Id)
+		assert.Equal(t, types.StringValue("vegan.pizza"), data.ZoneName)
+		assert.Equal(t, types.StringValue("vegan.pizza"), data.ZoneId)
+		assert.Equal(t, types.StringValue("demo"), data.Name)
+		assert.Equal(t, types.StringValue("demo.vegan.pizza"), data.QualifiedName)
+		assert.Equal(t, types.StringValue("2.3.4.5"), data.ValueNormalized)
+		assert.Equal(t, types.Int64Value(3600), data.TTL)
+		assert.Equal(t, types.Int64Value(0), data.Priority)
+	})
+
+	t.Run("other sources are left to Terraform", func(t *testing.T) {
+		for _, source := range [][2]string{
+			{"dnsimple_zone", "registry.terraform.io/dnsimple/dnsimple"},
+			{"dnsimple_record", "registry.terraform.io/hashicorp/other"},
+		} {
+			resp := move(source[0], source[1])
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.True(t, resp.TargetState.Raw.IsNull(), "%s from %s must not be moved", source[0], source[1])
+		}
+	})
+}
@@ -0,0 +1,291 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &TemplateApplicationResource{}
+	_ resource.ResourceWithConfigure = &TemplateApplicationResource{}
+)
+
+func NewTemplateApplicationResource() resource.Resource {
+	return &TemplateApplicationResource{}
+}
+
+// TemplateApplicationResource defines the resource implementation.
+type TemplateApplicationResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// TemplateApplicationResourceModel describes the resource data model.
+type TemplateApplicationResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Domain        types.String `tfsdk:"domain"`
+	TemplateId    types.String `tfsdk:"template_id"`
+	ZoneRecordIds types.Set    `tfsdk:"zone_record_ids"`
+}
+
+func (r *TemplateApplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_application"
+}
+
+func (r *TemplateApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple template application resource",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDStringAttribute(),
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain to apply the template to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "The ID or the SID of the template.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone_record_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the zone records created by applying the template, which are deleted along with the resource.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (r *TemplateApplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *TemplateApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TemplateApplicationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+
+	templateRecords, err := common.ListAllTemplateRecords(ctx, r.config.Client, r.config.AccountID, data.TemplateId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to apply DNSimple Template",
+			fmt.Sprintf("Unable to list the records of template '%s': %s", data.TemplateId.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// The API does not tell which records the template created, they are
+	// found by comparing the records of the zone before and after.
+	before, err := common.ListAllZoneRecords(ctx, r.config.Client, r.config.AccountID, domain, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to apply DNSimple Template",
+			fmt.Sprintf("Unable to list the records of zone '%s': %s", domain, err.Error()),
+		)
+		return
+	}
+
+	_, err = r.config.Client.Templates.ApplyTemplate(ctx, r.config.AccountID, data.TemplateId.ValueString(), domain)
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to apply DNSimple Template",
+			err.Error(),
+		)
+		return
+	}
+
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, domain)
+
+	after, err := common.ListAllZoneRecords(ctx, r.config.Client, r.config.AccountID, domain, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to apply DNSimple Template",
+			fmt.Sprintf("Unable to list the records of zone '%s': %s", domain, err.Error()),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s_%s", domain, data.TemplateId.ValueString()))
+	resp.Diagnostics.Append(r.setZoneRecordIds(ctx, templateRecordsCreated(templateRecords, before, after), data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TemplateApplicationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := common.ListAllZoneRecords(ctx, r.config.Client, r.config.AccountID, data.Domain.ValueString(), nil)
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, "removing template application from state because the domain is not present in the remote")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Template Application",
+			fmt.Sprintf("Unable to list the records of zone '%s': %s", data.Domain.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	recordIds := r.zoneRecordIds(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remaining := slices.DeleteFunc(slices.Clone(recordIds), func(id int64) bool {
+		return !slices.ContainsFunc(records, func(record dnsimple.ZoneRecord) bool { return record.ID == id })
+	})
+
+	if len(recordIds) > 0 && len(remaining) == 0 {
+		tflog.Warn(ctx, "removing template application from state because its records are not present in the remote")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setZoneRecordIds(ctx, remaining, data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No-op
+	tflog.Info(ctx, "template applications cannot be updated")
+}
+
+func (r *TemplateApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TemplateApplicationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordIds := r.zoneRecordIds(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting the records of DNSimple Template Application: %s", data.Id))
+
+	for _, id := range recordIds {
+		_, err := r.config.Client.Zones.DeleteRecord(ctx, r.config.AccountID, data.Domain.ValueString(), id)
+		if err != nil {
+			var errorResponse *dnsimple.ErrorResponse
+			if !errors.As(err, &errorResponse) || errorResponse.HTTPResponse == nil || errorResponse.HTTPResponse.StatusCode != http.StatusNotFound {
+				resp.Diagnostics.AddError(
+					"failed to delete DNSimple Template Application",
+					fmt.Sprintf("Unable to delete record %d of zone '%s': %s", id, data.Domain.ValueString(), err.Error()),
+				)
+			}
+		}
+	}
+
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, data.Domain.ValueString())
+}
+
+// templateRecordsCreated returns the IDs of the records added to the zone
+// that match the name and type of a template record, so that records created
+// concurrently by other means are left out.
+func templateRecordsCreated(templateRecords []dnsimple.TemplateRecord, before []dnsimple.ZoneRecord, after []dnsimple.ZoneRecord) []int64 {
+	var ids []int64
+
+	for _, record := range after {
+		if slices.ContainsFunc(before, func(existing dnsimple.ZoneRecord) bool { return existing.ID == record.ID }) {
+			continue
+		}
+
+		if slices.ContainsFunc(templateRecords, func(templateRecord dnsimple.TemplateRecord) bool {
+			return templateRecord.Name == record.Name && strings.EqualFold(templateRecord.Type, record.Type)
+		}) {
+			ids = append(ids, record.ID)
+		}
+	}
+
+	slices.Sort(ids)
+
+	return ids
+}
+
+func (r *TemplateApplicationResource) zoneRecordIds(ctx context.Context, data *TemplateApplicationResourceModel, diagnostics *diag.Diagnostics) []int64 {
+	var ids []int64
+
+	if !data.ZoneRecordIds.IsNull() && !data.ZoneRecordIds.IsUnknown() {
+		diagnostics.Append(data.ZoneRecordIds.ElementsAs(ctx, &ids, false)...)
+	}
+
+	return ids
+}
+
+func (r *TemplateApplicationResource) setZoneRecordIds(ctx context.Context, ids []int64, data *TemplateApplicationResourceModel) diag.Diagnostics {
+	if ids == nil {
+		ids = []int64{}
+	}
+
+	var diags diag.Diagnostics
+	data.ZoneRecordIds, diags = types.SetValueFrom(ctx, types.Int64Type, ids)
+
+	return diags
+}
@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateRecordsCreated(t *testing.T) {
+	templateRecords := []dnsimple.TemplateRecord{
+		{Name: "", Type: "A", Content: "192.0.2.1"},
+		{Name: "www", Type: "CNAME", Content: "example.com"},
+	}
+	before := []dnsimple.ZoneRecord{
+		{ID: 1, Name: "", Type: "NS", Content: "ns1.dnsimple.com"},
+		{ID: 2, Name: "www", Type: "CNAME", Content: "example.net"},
+	}
+	after := []dnsimple.ZoneRecord{
+		{ID: 1, Name: "", Type: "NS", Content: "ns1.dnsimple.com"},
+		// existed before the template was applied
+		{ID: 2, Name: "www", Type: "CNAME", Content: "example.net"},
+		{ID: 4, Name: "www", Type: "CNAME", Content: "example.com"},
+		{ID: 3, Name: "", Type: "A", Content: "192.0.2.1"},
+		// created concurrently, not by the template
+		{ID: 5, Name: "mail", Type: "A", Content: "192.0.2.2"},
+	}
+
+	assert.Equal(t, []int64{3, 4}, templateRecordsCreated(templateRecords, before, after))
+	assert.Empty(t, templateRecordsCreated(templateRecords, before, before))
+}
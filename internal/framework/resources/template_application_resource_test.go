@@ -0,0 +1,94 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+func TestAccTemplateApplicationResource(t *testing.T) {
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+	sid := strings.TrimSuffix(utils.RandomName("", "template"), ".")
+	resourceName := "dnsimple_template_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateApplicationResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateApplicationResourceConfig(domainName, sid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s_%s", domainName, sid)),
+					resource.TestCheckResourceAttr(resourceName, "domain", domainName),
+					resource.TestCheckResourceAttr(resourceName, "template_id", sid),
+					resource.TestCheckResourceAttr(resourceName, "zone_record_ids.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckTemplateApplicationResourceDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "dnsimple_template_application" {
+			continue
+		}
+
+		for key, value := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "zone_record_ids.") || key == "zone_record_ids.#" {
+				continue
+			}
+
+			recordID, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			_, err = dnsimpleClient.Zones.GetRecord(context.Background(), testAccAccount, rs.Primary.Attributes["domain"], recordID)
+			if err == nil {
+				return fmt.Errorf("zone record %d created by the template still exists", recordID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccTemplateApplicationResourceConfig(domainName string, sid string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_template" "test" {
+	sid  = %[2]q
+	name = "Terraform"
+}
+
+resource "dnsimple_template_record" "www" {
+	template_id = dnsimple_template.test.sid
+	name        = "terraform-template"
+	type        = "A"
+	value       = "192.0.2.1"
+}
+
+resource "dnsimple_template_record" "txt" {
+	template_id = dnsimple_template.test.sid
+	name        = "terraform-template"
+	type        = "TXT"
+	value       = "applied by terraform"
+}
+
+resource "dnsimple_template_application" "test" {
+	domain      = %[1]q
+	template_id = dnsimple_template.test.sid
+
+	depends_on = [dnsimple_template_record.www, dnsimple_template_record.txt]
+}`, domainName, sid)
+}
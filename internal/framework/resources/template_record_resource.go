@@ -0,0 +1,282 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/modifiers"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TemplateRecordResource{}
+	_ resource.ResourceWithConfigure   = &TemplateRecordResource{}
+	_ resource.ResourceWithImportState = &TemplateRecordResource{}
+)
+
+func NewTemplateRecordResource() resource.Resource {
+	return &TemplateRecordResource{}
+}
+
+// TemplateRecordResource defines the resource implementation.
+type TemplateRecordResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// TemplateRecordResourceModel describes the resource data model.
+type TemplateRecordResourceModel struct {
+	TemplateId types.String `tfsdk:"template_id"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	Id         types.Int64  `tfsdk:"id"`
+}
+
+func (r *TemplateRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_record"
+}
+
+func (r *TemplateRecordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Template records cannot be updated, every change replaces the record.
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple template record resource",
+		Attributes: map[string]schema.Attribute{
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "The ID or the SID of the template.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the record, relative to the domain the template is applied to. Use an empty string for the apex.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validators.RecordType{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validators.RecordValue{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					modifiers.Int64DefaultValue(3600),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					validators.RecordPriority{},
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"id": common.IDInt64Attribute(),
+		},
+	}
+}
+
+func (r *TemplateRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *TemplateRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TemplateRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordAttributes := dnsimple.TemplateRecord{
+		Name:    data.Name.ValueString(),
+		Type:    data.Type.ValueString(),
+		Content: data.Value.ValueString(),
+		TTL:     int(data.TTL.ValueInt64()),
+	}
+
+	if !data.Priority.IsNull() && !data.Priority.IsUnknown() {
+		recordAttributes.Priority = int(data.Priority.ValueInt64())
+	}
+
+	response, err := r.config.Client.Templates.CreateTemplateRecord(ctx, r.config.AccountID, data.TemplateId.ValueString(), recordAttributes)
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to create DNSimple Template Record",
+			err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(response.Data, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TemplateRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.config.Client.Templates.GetTemplateRecord(ctx, r.config.AccountID, data.TemplateId.ValueString(), data.Id.ValueInt64())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, "removing template record from state because it is not present in the remote")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Template Record",
+			fmt.Sprintf("Unable to read record %d of template '%s': %s", data.Id.ValueInt64(), data.TemplateId.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(response.Data, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No-op
+	tflog.Info(ctx, "template records cannot be updated")
+}
+
+func (r *TemplateRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TemplateRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting DNSimple Template Record: %s, %s", data.TemplateId, data.Id))
+
+	_, err := r.config.Client.Templates.DeleteTemplateRecord(ctx, r.config.AccountID, data.TemplateId.ValueString(), data.Id.ValueInt64())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if !errors.As(err, &errorResponse) || errorResponse.HTTPResponse == nil || errorResponse.HTTPResponse.StatusCode != http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"failed to delete DNSimple Template Record",
+				fmt.Sprintf("Unable to delete record %d of template '%s': %s", data.Id.ValueInt64(), data.TemplateId.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+// ImportState accepts an ID given as <template>_<record-id>, where the
+// template is given by ID or SID.
+func (r *TemplateRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	separator := strings.LastIndex(req.ID, "_")
+	if separator <= 0 {
+		resp.Diagnostics.AddError(
+			"invalid import ID",
+			fmt.Sprintf("Invalid import ID format '%s'. Expected format: '<template>_<record-id>'", req.ID),
+		)
+		return
+	}
+
+	recordID, err := strconv.ParseInt(req.ID[separator+1:], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"invalid import ID",
+			fmt.Sprintf("Unable to parse record ID '%s' as integer. Expected a numeric ID", req.ID[separator+1:]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), recordID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_id"), req.ID[:separator])...)
+}
+
+func (r *TemplateRecordResource) updateModelFromAPIResponse(record *dnsimple.TemplateRecord, data *TemplateRecordResourceModel) {
+	data.Id = types.Int64Value(record.ID)
+	data.Type = types.StringValue(record.Type)
+	data.TTL = types.Int64Value(int64(record.TTL))
+	data.Priority = types.Int64Value(int64(record.Priority))
+
+	// The API may normalize the name and the content, keep them as configured
+	// unless they are not in the state, as during a resource import.
+	if data.Name.IsNull() || data.Name.IsUnknown() {
+		data.Name = types.StringValue(record.Name)
+	}
+
+	if data.Value.IsNull() || data.Value.IsUnknown() {
+		data.Value = types.StringValue(record.Content)
+	}
+}
@@ -0,0 +1,98 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+func TestAccTemplateRecordResource(t *testing.T) {
+	sid := strings.TrimSuffix(utils.RandomName("", "template"), ".")
+	resourceName := "dnsimple_template_record.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateRecordResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateRecordResourceConfig(sid, "192.0.2.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "template_id", sid),
+					resource.TestCheckResourceAttr(resourceName, "name", "www"),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "value", "192.0.2.1"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+				),
+			},
+			{
+				Config: testAccTemplateRecordResourceConfig(sid, "192.0.2.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "192.0.2.2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccTemplateRecordImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTemplateRecordImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s_%s", rs.Primary.Attributes["template_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckTemplateRecordResourceDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "dnsimple_template_record" {
+			continue
+		}
+
+		recordID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = dnsimpleClient.Templates.GetTemplateRecord(context.Background(), testAccAccount, rs.Primary.Attributes["template_id"], recordID)
+		if err == nil {
+			return fmt.Errorf("template record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccTemplateRecordResourceConfig(sid string, value string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_template" "test" {
+	sid  = %[1]q
+	name = "Terraform"
+}
+
+resource "dnsimple_template_record" "test" {
+	template_id = dnsimple_template.test.sid
+	name        = "www"
+	type        = "A"
+	value       = %[2]q
+}`, sid, value)
+}
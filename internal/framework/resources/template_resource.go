@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/modifiers"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TemplateResource{}
+	_ resource.ResourceWithConfigure   = &TemplateResource{}
+	_ resource.ResourceWithImportState = &TemplateResource{}
+)
+
+func NewTemplateResource() resource.Resource {
+	return &TemplateResource{}
+}
+
+// TemplateResource defines the resource implementation.
+type TemplateResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// TemplateResourceModel describes the resource data model.
+type TemplateResourceModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	AccountId   types.Int64  `tfsdk:"account_id"`
+	SID         types.String `tfsdk:"sid"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *TemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template"
+}
+
+func (r *TemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple template resource",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDInt64Attribute(),
+			"account_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"sid": schema.StringAttribute{
+				MarkdownDescription: "The short name of the template, which identifies it along with its ID.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{modifiers.StringDefaultValue("")},
+			},
+		},
+	}
+}
+
+func (r *TemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *TemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.config.Client.Templates.CreateTemplate(ctx, r.config.AccountID, dnsimple.Template{
+		SID:         data.SID.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	})
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to create DNSimple Template",
+			err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(response.Data, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.config.Client.Templates.GetTemplate(ctx, r.config.AccountID, strconv.FormatInt(data.Id.ValueInt64(), 10))
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, "removing template from state because it is not present in the remote")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Template",
+			fmt.Sprintf("Unable to read template '%s': %s", data.SID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(response.Data, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		planData  *TemplateResourceModel
+		stateData *TemplateResourceModel
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template, err := common.UpdateTemplate(ctx, r.config.Client, r.config.AccountID, stateData.Id.ValueInt64(), dnsimple.Template{
+		SID:         planData.SID.ValueString(),
+		Name:        planData.Name.ValueString(),
+		Description: planData.Description.ValueString(),
+	})
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to update DNSimple Template",
+			err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(template, planData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting DNSimple Template: %s, %s", data.SID, data.Id))
+
+	_, err := r.config.Client.Templates.DeleteTemplate(ctx, r.config.AccountID, strconv.FormatInt(data.Id.ValueInt64(), 10))
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if !errors.As(err, &errorResponse) || errorResponse.HTTPResponse == nil || errorResponse.HTTPResponse.StatusCode != http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"failed to delete DNSimple Template",
+				fmt.Sprintf("Unable to delete template '%s': %s", data.SID.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+// ImportState accepts the ID or the SID of the template.
+func (r *TemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	response, err := r.config.Client.Templates.GetTemplate(ctx, r.config.AccountID, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to import DNSimple Template",
+			fmt.Sprintf("Unable to find template '%s': %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.Data.ID)...)
+}
+
+func (r *TemplateResource) updateModelFromAPIResponse(template *dnsimple.Template, data *TemplateResourceModel) {
+	data.Id = types.Int64Value(template.ID)
+	data.AccountId = types.Int64Value(template.AccountID)
+	data.SID = types.StringValue(template.SID)
+	data.Name = types.StringValue(template.Name)
+	data.Description = types.StringValue(template.Description)
+}
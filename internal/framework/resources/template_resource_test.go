@@ -0,0 +1,74 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+func TestAccTemplateResource(t *testing.T) {
+	sid := strings.TrimSuffix(utils.RandomName("", "template"), ".")
+	resourceName := "dnsimple_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateResourceConfig(sid, "Terraform", `description = "Created by Terraform"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "sid", sid),
+					resource.TestCheckResourceAttr(resourceName, "name", "Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created by Terraform"),
+				),
+			},
+			{
+				Config: testAccTemplateResourceConfig(sid, "Terraform renamed", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Terraform renamed"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     sid,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckTemplateResourceDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "dnsimple_template" {
+			continue
+		}
+
+		_, err := dnsimpleClient.Templates.GetTemplate(context.Background(), testAccAccount, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("template still exists")
+		}
+	}
+	return nil
+}
+
+func testAccTemplateResourceConfig(sid string, name string, extra string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_template" "test" {
+	sid  = %[1]q
+	name = %[2]q
+	%[3]s
+}`, sid, name, extra)
+}
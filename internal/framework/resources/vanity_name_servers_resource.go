@@ -0,0 +1,284 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &VanityNameServersResource{}
+	_ resource.ResourceWithConfigure   = &VanityNameServersResource{}
+	_ resource.ResourceWithImportState = &VanityNameServersResource{}
+)
+
+func NewVanityNameServersResource() resource.Resource {
+	return &VanityNameServersResource{}
+}
+
+// VanityNameServersResource defines the resource implementation.
+type VanityNameServersResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// VanityNameServersResourceModel describes the resource data model.
+type VanityNameServersResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Domain      types.String `tfsdk:"domain"`
+	NameServers types.List   `tfsdk:"name_servers"`
+}
+
+type VanityNameServerModel struct {
+	Name types.String `tfsdk:"name"`
+	IPv4 types.String `tfsdk:"ipv4"`
+	IPv6 types.String `tfsdk:"ipv6"`
+}
+
+var VanityNameServerAttrType = map[string]attr.Type{
+	"name": types.StringType,
+	"ipv4": types.StringType,
+	"ipv6": types.StringType,
+}
+
+func (r *VanityNameServersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vanity_name_servers"
+}
+
+func (r *VanityNameServersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple vanity name servers resource",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDStringAttribute(),
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain to enable vanity name servers for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_servers": schema.ListNestedAttribute{
+				MarkdownDescription: "The vanity name servers of the domain.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the name server.",
+							Computed:            true,
+						},
+						"ipv4": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 address of the name server.",
+							Computed:            true,
+						},
+						"ipv6": schema.StringAttribute{
+							MarkdownDescription: "The IPv6 address of the name server.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *VanityNameServersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *VanityNameServersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *VanityNameServersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.config.Client.VanityNameServers.EnableVanityNameServers(ctx, r.config.AccountID, data.Domain.ValueString())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to enable DNSimple Vanity Name Servers",
+			err.Error(),
+		)
+		return
+	}
+
+	// Enabling vanity name servers replaces the NS records of the zone.
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, data.Domain.ValueString())
+
+	data.Id = data.Domain
+	resp.Diagnostics.Append(r.updateModelFromAPIResponse(ctx, response.Data, data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VanityNameServersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *VanityNameServersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The names returned when the vanity name servers were enabled are looked
+	// for, as white-label name servers are not named after the domain. An
+	// imported resource does not know them yet.
+	var prior []VanityNameServerModel
+	if !data.NameServers.IsNull() && !data.NameServers.IsUnknown() {
+		resp.Diagnostics.Append(data.NameServers.ElementsAs(ctx, &prior, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var names []string
+	for _, nameServer := range prior {
+		names = append(names, nameServer.Name.ValueString())
+	}
+
+	nameServers, err := common.ListVanityNameServers(ctx, r.config.Client, r.config.AccountID, data.Domain.ValueString(), names)
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, "removing vanity name servers from state because the domain is not present in the remote")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Vanity Name Servers",
+			fmt.Sprintf("Unable to read the vanity name servers of domain '%s': %s", data.Domain.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Disabling vanity name servers resets the NS records of the zone, so
+	// none of the names is published anymore.
+	if len(nameServers) == 0 {
+		tflog.Warn(ctx, "removing vanity name servers from state because they are disabled in the remote")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Addresses that are not in the zone keep the ones returned on enable.
+	for i, nameServer := range nameServers {
+		index := slices.IndexFunc(prior, func(model VanityNameServerModel) bool { return model.Name.ValueString() == nameServer.Name })
+		if index < 0 {
+			continue
+		}
+		if nameServer.IPv4 == "" {
+			nameServers[i].IPv4 = prior[index].IPv4.ValueString()
+		}
+		if nameServer.IPv6 == "" {
+			nameServers[i].IPv6 = prior[index].IPv6.ValueString()
+		}
+	}
+
+	data.Id = data.Domain
+	resp.Diagnostics.Append(r.updateModelFromAPIResponse(ctx, nameServers, data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VanityNameServersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No-op
+	tflog.Info(ctx, "vanity name servers cannot be updated")
+}
+
+func (r *VanityNameServersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *VanityNameServersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Disabling DNSimple Vanity Name Servers: %s", data.Domain))
+
+	_, err := r.config.Client.VanityNameServers.DisableVanityNameServers(ctx, r.config.AccountID, data.Domain.ValueString())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if !errors.As(err, &errorResponse) || errorResponse.HTTPResponse == nil || errorResponse.HTTPResponse.StatusCode != http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"failed to disable DNSimple Vanity Name Servers",
+				fmt.Sprintf("Unable to disable the vanity name servers of domain '%s': %s", data.Domain.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, data.Domain.ValueString())
+}
+
+func (r *VanityNameServersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), req.ID)...)
+}
+
+// updateModelFromAPIResponse sets the name servers sorted by name, the order
+// they are read back in from the zone.
+func (r *VanityNameServersResource) updateModelFromAPIResponse(ctx context.Context, nameServers []dnsimple.VanityNameServer, data *VanityNameServersResourceModel) diag.Diagnostics {
+	nameServers = slices.SortedFunc(slices.Values(nameServers), func(a, b dnsimple.VanityNameServer) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	models := make([]VanityNameServerModel, 0, len(nameServers))
+	for _, nameServer := range nameServers {
+		models = append(models, VanityNameServerModel{
+			Name: types.StringValue(nameServer.Name),
+			IPv4: types.StringValue(nameServer.IPv4),
+			IPv6: types.StringValue(nameServer.IPv6),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.NameServers, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VanityNameServerAttrType}, models)
+
+	return diags
+}
@@ -0,0 +1,68 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccVanityNameServersResource(t *testing.T) {
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+	resourceName := "dnsimple_vanity_name_servers.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVanityNameServersResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVanityNameServersResourceConfig(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", domainName),
+					resource.TestCheckResourceAttr(resourceName, "name_servers.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "name_servers.0.name", "ns1."+domainName),
+					resource.TestCheckResourceAttrSet(resourceName, "name_servers.0.ipv4"),
+					resource.TestCheckResourceAttrSet(resourceName, "name_servers.0.ipv6"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     domainName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckVanityNameServersResourceDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "dnsimple_vanity_name_servers" {
+			continue
+		}
+
+		nameServers, err := common.ListVanityNameServers(context.Background(), dnsimpleClient, testAccAccount, rs.Primary.Attributes["domain"], nil)
+		if err != nil {
+			return err
+		}
+		if len(nameServers) > 0 {
+			return fmt.Errorf("vanity name servers are still enabled")
+		}
+	}
+	return nil
+}
+
+func testAccVanityNameServersResourceConfig(domainName string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_vanity_name_servers" "test" {
+	domain = %[1]q
+}`, domainName)
+}
@@ -0,0 +1,78 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccWebhookResource(t *testing.T) {
+	resourceName := "dnsimple_webhook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckWebhookResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceConfig("https://example.com/webhooks/dnsimple"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/webhooks/dnsimple"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccWebhookResource_InvalidURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebhookResourceConfig("example.com/webhooks"),
+				ExpectError: regexp.MustCompile("Invalid webhook URL"),
+			},
+		},
+	})
+}
+
+func testAccCheckWebhookResourceDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "dnsimple_webhook" {
+			continue
+		}
+
+		webhookID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = dnsimpleClient.Webhooks.GetWebhook(context.Background(), testAccAccount, webhookID)
+		if err == nil {
+			return fmt.Errorf("webhook still exists")
+		}
+	}
+	return nil
+}
+
+func testAccWebhookResourceConfig(url string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_webhook" "test" {
+	url = %[1]q
+}`, url)
+}
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+const (
+	// defaultDistributionTimeout is how long to wait for a change to be
+	// distributed when no create or update timeout is configured.
+	defaultDistributionTimeout = 5 * time.Minute
+
+	// The delay between two distribution checks starts at
+	// distributionCheckDelay and doubles up to distributionCheckMaxDelay.
+	distributionCheckDelay    = 2 * time.Second
+	distributionCheckMaxDelay = 30 * time.Second
+)
+
+// waitForDistributionAttribute returns the schema of the wait_for_distribution
+// attribute shared by the zone and zone record resources.
+func waitForDistributionAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+// waitForZoneDistribution waits until the zone is distributed to all the
+// DNSimple name servers.
+func waitForZoneDistribution(ctx context.Context, config *common.DnsimpleProviderConfig, zoneName string, timeout time.Duration) error {
+	return waitForDistribution(ctx, fmt.Sprintf("zone '%s'", zoneName), timeout, func() (*dnsimple.ZoneDistributionResponse, error) {
+		return config.Client.Zones.CheckZoneDistribution(ctx, config.AccountID, zoneName)
+	})
+}
+
+// waitForZoneRecordDistribution waits until the zone record is distributed
+// to all the DNSimple name servers.
+func waitForZoneRecordDistribution(ctx context.Context, config *common.DnsimpleProviderConfig, zoneName string, recordID int64, timeout time.Duration) error {
+	return waitForDistribution(ctx, fmt.Sprintf("zone record %d of zone '%s'", recordID, zoneName), timeout, func() (*dnsimple.ZoneDistributionResponse, error) {
+		return config.Client.Zones.CheckZoneRecordDistribution(ctx, config.AccountID, zoneName, recordID)
+	})
+}
+
+// waitForDistribution polls the distribution check until it reports the
+// change distributed, backing off between checks. Checks the API could not
+// complete, which it reports with a server error, are retried; other errors
+// stop the wait.
+func waitForDistribution(ctx context.Context, subject string, timeout time.Duration, check func() (*dnsimple.ZoneDistributionResponse, error)) error {
+	return utils.RetryWithBackoff(ctx, func() (error, bool) {
+		response, err := check()
+		if err != nil {
+			var errorResponse *dnsimple.ErrorResponse
+			if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode < http.StatusInternalServerError {
+				return err, true
+			}
+
+			tflog.Info(ctx, fmt.Sprintf("[RETRYING] Distribution check of %s failed: %s", subject, err.Error()))
+			return err, false
+		}
+
+		if !response.Data.Distributed {
+			tflog.Info(ctx, fmt.Sprintf("[RETRYING] %s is not distributed yet", subject))
+			return fmt.Errorf("%s is not distributed to all the name servers yet", subject), false
+		}
+
+		return nil, false
+	}, timeout, distributionCheckDelay, distributionCheckMaxDelay)
+}
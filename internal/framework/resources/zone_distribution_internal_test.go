@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+)
+
+func distributionResponse(distributed bool) *dnsimple.ZoneDistributionResponse {
+	return &dnsimple.ZoneDistributionResponse{Data: &dnsimple.ZoneDistribution{Distributed: distributed}}
+}
+
+func TestWaitForDistribution(t *testing.T) {
+	checks := 0
+	err := waitForDistribution(context.Background(), "zone 'example.com'", time.Minute, func() (*dnsimple.ZoneDistributionResponse, error) {
+		checks++
+		return distributionResponse(true), nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, checks)
+}
+
+func TestWaitForDistribution_NotDistributed(t *testing.T) {
+	err := waitForDistribution(context.Background(), "zone 'example.com'", 0, func() (*dnsimple.ZoneDistributionResponse, error) {
+		return distributionResponse(false), nil
+	})
+
+	assert.EqualError(t, err, "zone 'example.com' is not distributed to all the name servers yet")
+}
+
+func TestWaitForDistribution_ClientError(t *testing.T) {
+	checks := 0
+	err := waitForDistribution(context.Background(), "zone 'example.com'", time.Minute, func() (*dnsimple.ZoneDistributionResponse, error) {
+		checks++
+		return nil, &dnsimple.ErrorResponse{
+			Response: dnsimple.Response{HTTPResponse: &http.Response{StatusCode: http.StatusNotFound}},
+			Message:  "Zone `example.com` not found",
+		}
+	})
+
+	// Errors other than failed checks stop the wait right away.
+	assert.Error(t, err)
+	assert.Equal(t, 1, checks)
+}
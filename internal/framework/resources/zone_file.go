@@ -0,0 +1,458 @@
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// zoneFileDefaultTTL is the TTL of records that set none, when the zone file
+// has no $TTL directive either.
+const zoneFileDefaultTTL = 3600
+
+// zoneFileToken is a single field of a zone file entry.
+type zoneFileToken struct {
+	// value is the field with quotes and escapes removed.
+	value string
+	// raw is the field as written in the zone file.
+	raw    string
+	quoted bool
+}
+
+// zoneFileEntry is a logical line of a zone file. An entry spans several lines
+// when its fields are wrapped in parentheses.
+type zoneFileEntry struct {
+	line   int
+	tokens []zoneFileToken
+	// inheritsOwner is set when the entry starts with a blank, in which case
+	// the owner of the previous record applies.
+	inheritsOwner bool
+}
+
+// zoneFileHostnameTypes are the record types whose content is a single host name.
+var zoneFileHostnameTypes = map[string]bool{
+	"ALIAS": true,
+	"CNAME": true,
+	"NS":    true,
+	"PTR":   true,
+}
+
+// parseZoneFile parses a zone file in the master file format described in
+// RFC 1035, section 5, and returns its records in the form used by the
+// DNSimple API: names relative to the zone, host names without the trailing
+// dot, and the priority of MX and SRV records split from the content.
+//
+// The $ORIGIN and $TTL directives are supported. $INCLUDE and $GENERATE are
+// not, since the zone file must be self-contained.
+func parseZoneFile(zoneName string, text string) ([]dnsimple.ZoneRecord, error) {
+	entries, err := tokenizeZoneFile(text)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := zoneFileFQDN(zoneName)
+	origin := zone
+	defaultTTL := zoneFileDefaultTTL
+	previousOwner := ""
+	records := []dnsimple.ZoneRecord{}
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if !entry.inheritsOwner && strings.HasPrefix(tokens[0].raw, "$") {
+			directive := strings.ToUpper(tokens[0].raw)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) < 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", entry.line)
+				}
+				origin = zoneFileAbsoluteName(tokens[1].raw, origin)
+			case "$TTL":
+				if len(tokens) < 2 {
+					return nil, fmt.Errorf("line %d: $TTL requires a value", entry.line)
+				}
+				ttl, ok := parseZoneFileTTL(tokens[1].raw)
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid $TTL value %q", entry.line, tokens[1].raw)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", entry.line, tokens[0].raw)
+			}
+			continue
+		}
+
+		owner := previousOwner
+		if !entry.inheritsOwner {
+			owner = zoneFileAbsoluteName(tokens[0].raw, origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", entry.line)
+		}
+		previousOwner = owner
+
+		ttl := defaultTTL
+		// The TTL and the class are both optional and may come in any order.
+	fields:
+		for range 2 {
+			if len(tokens) == 0 {
+				break
+			}
+			if value, ok := parseZoneFileTTL(tokens[0].raw); ok {
+				ttl = value
+				tokens = tokens[1:]
+				continue
+			}
+			switch strings.ToUpper(tokens[0].raw) {
+			case "IN":
+				tokens = tokens[1:]
+			case "CH", "CS", "HS":
+				return nil, fmt.Errorf("line %d: unsupported class %s, only IN is supported", entry.line, tokens[0].raw)
+			default:
+				// The type of the record.
+				break fields
+			}
+		}
+
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record requires a type and data", entry.line)
+		}
+
+		name, err := zoneFileRelativeName(owner, zone)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", entry.line, err)
+		}
+
+		record := dnsimple.ZoneRecord{
+			Name: name,
+			Type: strings.ToUpper(tokens[0].raw),
+			TTL:  ttl,
+		}
+		if err := setZoneFileRecordData(&record, tokens[1:], origin); err != nil {
+			return nil, fmt.Errorf("line %d: %w", entry.line, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// setZoneFileRecordData fills the content and priority of the record from the
+// data fields of the zone file entry.
+func setZoneFileRecordData(record *dnsimple.ZoneRecord, data []zoneFileToken, origin string) error {
+	switch {
+	case record.Type == "MX":
+		if len(data) != 2 {
+			return fmt.Errorf("MX record requires a preference and an exchange")
+		}
+		priority, err := strconv.Atoi(data[0].raw)
+		if err != nil {
+			return fmt.Errorf("invalid MX preference %q", data[0].raw)
+		}
+		record.Priority = priority
+		record.Content = zoneFileHostname(data[1].raw, origin)
+	case record.Type == "SRV":
+		if len(data) != 4 {
+			return fmt.Errorf("SRV record requires a priority, a weight, a port and a target")
+		}
+		priority, err := strconv.Atoi(data[0].raw)
+		if err != nil {
+			return fmt.Errorf("invalid SRV priority %q", data[0].raw)
+		}
+		record.Priority = priority
+		record.Content = fmt.Sprintf("%s %s %s", data[1].raw, data[2].raw, zoneFileHostname(data[3].raw, origin))
+	case zoneFileHostnameTypes[record.Type]:
+		if len(data) != 1 {
+			return fmt.Errorf("%s record requires a single host name", record.Type)
+		}
+		record.Content = zoneFileHostname(data[0].raw, origin)
+	case (record.Type == "TXT" || record.Type == "SPF") && len(data) == 1:
+		record.Content = data[0].value
+	default:
+		fields := make([]string, 0, len(data))
+		for _, token := range data {
+			fields = append(fields, token.raw)
+		}
+		record.Content = strings.Join(fields, " ")
+	}
+
+	return nil
+}
+
+// tokenizeZoneFile splits a zone file into entries, dropping comments and
+// joining the lines wrapped in parentheses.
+func tokenizeZoneFile(text string) ([]zoneFileEntry, error) {
+	var (
+		entries    []zoneFileEntry
+		entry      zoneFileEntry
+		token      strings.Builder
+		raw        strings.Builder
+		inToken    bool
+		quoted     bool
+		inQuotes   bool
+		parens     int
+		line       = 1
+		quoteLine  int
+		parenLine  int
+		lineStart  = true
+		entryStart = 1
+	)
+
+	flushToken := func() {
+		if inToken {
+			entry.tokens = append(entry.tokens, zoneFileToken{value: token.String(), raw: raw.String(), quoted: quoted})
+		}
+		token.Reset()
+		raw.Reset()
+		inToken = false
+		quoted = false
+	}
+	flushEntry := func() {
+		flushToken()
+		if len(entry.tokens) > 0 {
+			entry.line = entryStart
+			entries = append(entries, entry)
+		}
+		entry = zoneFileEntry{}
+	}
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if inQuotes {
+			switch r {
+			case '"':
+				raw.WriteRune(r)
+				inQuotes = false
+			case '\\':
+				raw.WriteRune(r)
+				if i+1 < len(runes) {
+					i++
+					start := i
+					if err := unescapeZoneFile(runes, &i, &token); err != nil {
+						return nil, fmt.Errorf("line %d: %w", line, err)
+					}
+					raw.WriteString(string(runes[start : i+1]))
+				}
+			default:
+				if r == '\n' {
+					line++
+				}
+				raw.WriteRune(r)
+				token.WriteRune(r)
+			}
+			continue
+		}
+
+		if lineStart && parens == 0 {
+			entryStart = line
+			entry.inheritsOwner = r == ' ' || r == '\t'
+		}
+		lineStart = false
+
+		switch {
+		case r == '\n':
+			line++
+			lineStart = true
+			if parens == 0 {
+				flushEntry()
+			} else {
+				flushToken()
+			}
+		case r == ';':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case r == '(':
+			flushToken()
+			if parens == 0 {
+				parenLine = line
+			}
+			parens++
+		case r == ')':
+			flushToken()
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced closing parenthesis", line)
+			}
+			parens--
+		case r == '"':
+			flushToken()
+			inToken = true
+			quoted = true
+			inQuotes = true
+			quoteLine = line
+			raw.WriteRune(r)
+		case unicode.IsSpace(r):
+			flushToken()
+		case r == '\\' && i+1 < len(runes):
+			inToken = true
+			raw.WriteRune(r)
+			i++
+			start := i
+			if err := unescapeZoneFile(runes, &i, &token); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			raw.WriteString(string(runes[start : i+1]))
+		default:
+			inToken = true
+			raw.WriteRune(r)
+			token.WriteRune(r)
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", quoteLine)
+	}
+	if parens > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced opening parenthesis", parenLine)
+	}
+	flushEntry()
+
+	return entries, nil
+}
+
+// unescapeZoneFile writes the character of the escape sequence starting at
+// runes[*i] to token, advancing *i to the last character of a \DDD decimal
+// escape. A decimal escape stands for a single octet, written as is.
+func unescapeZoneFile(runes []rune, i *int, token *strings.Builder) error {
+	if *i+2 < len(runes) && isASCIIDigit(runes[*i]) && isASCIIDigit(runes[*i+1]) && isASCIIDigit(runes[*i+2]) {
+		value, err := strconv.Atoi(string(runes[*i : *i+3]))
+		if err != nil || value > 255 {
+			return fmt.Errorf("invalid escape sequence \\%s", string(runes[*i:*i+3]))
+		}
+
+		*i += 2
+		token.WriteByte(byte(value))
+		return nil
+	}
+
+	token.WriteRune(runes[*i])
+	return nil
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// parseZoneFileTTL parses a TTL given in seconds, or with the BIND unit
+// suffixes such as 1h30m.
+func parseZoneFileTTL(value string) (int, bool) {
+	if value == "" || !unicode.IsDigit(rune(value[0])) {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return seconds, true
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, current := 0, 0
+	hasDigits := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= '0' && c <= '9':
+			current = current*10 + int(c-'0')
+			hasDigits = true
+		case units[c|0x20] > 0 && hasDigits:
+			total += current * units[c|0x20]
+			current = 0
+			hasDigits = false
+		default:
+			return 0, false
+		}
+	}
+	if hasDigits {
+		return 0, false
+	}
+
+	return total, true
+}
+
+func zoneFileFQDN(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
+
+// zoneFileAbsoluteName resolves a name of the zone file against the origin.
+func zoneFileAbsoluteName(name string, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+// zoneFileRelativeName returns the name of an owner relative to the zone, as
+// the API expects it.
+func zoneFileRelativeName(owner string, zone string) (string, error) {
+	if owner == zone {
+		return "", nil
+	}
+	if name, ok := strings.CutSuffix(owner, "."+zone); ok {
+		return name, nil
+	}
+
+	return "", fmt.Errorf("name %s is outside of zone %s", owner, zone)
+}
+
+// zoneFileHostname resolves a host name of the zone file and drops the
+// trailing dot, as the API stores host names fully qualified without it.
+func zoneFileHostname(name string, origin string) string {
+	if name == "." {
+		return name
+	}
+
+	return strings.TrimSuffix(zoneFileAbsoluteName(name, origin), ".")
+}
+
+// renderZoneFile renders records in the master file format, relative to an
+// $ORIGIN set to the zone.
+func renderZoneFile(zoneName string, records []dnsimple.ZoneRecord) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "$ORIGIN %s\n", zoneFileFQDN(zoneName))
+
+	for _, record := range records {
+		owner := record.Name
+		if owner == "" {
+			owner = "@"
+		}
+
+		content := record.Content
+		switch {
+		case record.Type == "MX":
+			content = fmt.Sprintf("%d %s", record.Priority, renderZoneFileHostname(content))
+		case record.Type == "SRV":
+			fields := strings.Fields(content)
+			if len(fields) > 0 {
+				fields[len(fields)-1] = renderZoneFileHostname(fields[len(fields)-1])
+			}
+			content = fmt.Sprintf("%d %s", record.Priority, strings.Join(fields, " "))
+		case zoneFileHostnameTypes[record.Type]:
+			content = renderZoneFileHostname(content)
+		case record.Type == "TXT" || record.Type == "SPF":
+			if !strings.HasPrefix(content, `"`) {
+				content = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(content) + `"`
+			}
+		}
+
+		fmt.Fprintf(&builder, "%s %d IN %s %s\n", owner, record.TTL, record.Type, content)
+	}
+
+	return builder.String()
+}
+
+func renderZoneFileHostname(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
@@ -0,0 +1,178 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	zoneFile := `$ORIGIN example.com.
+$TTL 1h
+@ IN SOA ns1.dnsimple.com. admin.dnsimple.com. (
+	1 86400 7200 604800 300 ) ; serial and timers
+@		NS	ns1.dnsimple.com.
+		A	192.0.2.1
+www	300	IN	CNAME	@
+mail	IN	1d	A	192.0.2.2
+@		MX	10 mail
+_sip._tcp	SRV	10 60 5060 sip.example.net.
+@		TXT	"v=spf1 include:_spf.example.net -all"
+long		TXT	"first part" "second \"part\""
+@		CAA	0 issue "letsencrypt.org"
+$ORIGIN sub.example.com.
+api		A	192.0.2.3
+`
+
+	records, err := parseZoneFile("example.com", zoneFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{Name: "", Type: "SOA", Content: "ns1.dnsimple.com. admin.dnsimple.com. 1 86400 7200 604800 300", TTL: 3600},
+		{Name: "", Type: "NS", Content: "ns1.dnsimple.com", TTL: 3600},
+		{Name: "", Type: "A", Content: "192.0.2.1", TTL: 3600},
+		{Name: "www", Type: "CNAME", Content: "example.com", TTL: 300},
+		{Name: "mail", Type: "A", Content: "192.0.2.2", TTL: 86400},
+		{Name: "", Type: "MX", Content: "mail.example.com", TTL: 3600, Priority: 10},
+		{Name: "_sip._tcp", Type: "SRV", Content: "60 5060 sip.example.net", TTL: 3600, Priority: 10},
+		{Name: "", Type: "TXT", Content: "v=spf1 include:_spf.example.net -all", TTL: 3600},
+		{Name: "long", Type: "TXT", Content: `"first part" "second \"part\""`, TTL: 3600},
+		{Name: "", Type: "CAA", Content: `0 issue "letsencrypt.org"`, TTL: 3600},
+		{Name: "api.sub", Type: "A", Content: "192.0.2.3", TTL: 3600},
+	}, records)
+}
+
+func TestParseZoneFile_DefaultTTL(t *testing.T) {
+	records, err := parseZoneFile("example.com.", "www A 192.0.2.1\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{Name: "www", Type: "A", Content: "192.0.2.1", TTL: zoneFileDefaultTTL},
+	}, records)
+}
+
+func TestParseZoneFile_TTLAndClass(t *testing.T) {
+	records, err := parseZoneFile("example.com.", `www IN 300 A 192.0.2.1
+api 600 IN A 192.0.2.2
+mail IN A 192.0.2.3
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{Name: "www", Type: "A", Content: "192.0.2.1", TTL: 300},
+		{Name: "api", Type: "A", Content: "192.0.2.2", TTL: 600},
+		{Name: "mail", Type: "A", Content: "192.0.2.3", TTL: zoneFileDefaultTTL},
+	}, records)
+}
+
+func TestParseZoneFile_ZeroTTL(t *testing.T) {
+	records, err := parseZoneFile("example.com.", `www 0 A 192.0.2.1
+$TTL 0
+api A 192.0.2.2
+mail 300 A 192.0.2.3
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{Name: "www", Type: "A", Content: "192.0.2.1", TTL: 0},
+		{Name: "api", Type: "A", Content: "192.0.2.2", TTL: 0},
+		{Name: "mail", Type: "A", Content: "192.0.2.3", TTL: 300},
+	}, records)
+}
+
+func TestParseZoneFile_DecimalEscapes(t *testing.T) {
+	records, err := parseZoneFile("example.com.", `txt TXT "a\065\255z"
+spaced TXT a\032b
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{Name: "txt", Type: "TXT", Content: "aA\xffz", TTL: zoneFileDefaultTTL},
+		{Name: "spaced", Type: "TXT", Content: "a b", TTL: zoneFileDefaultTTL},
+	}, records)
+}
+
+func TestParseZoneFile_Errors(t *testing.T) {
+	tests := map[string]string{
+		"outside of zone":      "www.example.org. A 192.0.2.1\n",
+		"unsupported class":    "www CH A 192.0.2.1\n",
+		"missing data":         "www A\n",
+		"no owner":             "  A 192.0.2.1\n",
+		"include directive":    "$INCLUDE other.zone\n",
+		"unterminated quote":   "www TXT \"unterminated\n",
+		"unbalanced paren":     "www TXT ( \"text\"\n",
+		"invalid escape":       "www TXT \"\\256\"\n",
+		"invalid MX":           "@ MX mail.example.com.\n",
+		"invalid SRV priority": "_sip._tcp SRV high 60 5060 sip.example.net.\n",
+	}
+
+	for name, zoneFile := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseZoneFile("example.com", zoneFile)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	tests := map[string]int{
+		"300":    300,
+		"1h":     3600,
+		"1h30m":  5400,
+		"1W":     604800,
+		"2d12h5": -1,
+		"A":      -1,
+		"10x":    -1,
+	}
+
+	for value, expected := range tests {
+		ttl, ok := parseZoneFileTTL(value)
+		if expected < 0 {
+			assert.False(t, ok, value)
+			continue
+		}
+		assert.True(t, ok, value)
+		assert.Equal(t, expected, ttl, value)
+	}
+}
+
+func TestRenderZoneFile_RoundTrip(t *testing.T) {
+	records := []dnsimple.ZoneRecord{
+		{Name: "", Type: "A", Content: "192.0.2.1", TTL: 3600},
+		{Name: "www", Type: "CNAME", Content: "example.com", TTL: 300},
+		{Name: "", Type: "MX", Content: "mail.example.com", TTL: 3600, Priority: 10},
+		{Name: "_sip._tcp", Type: "SRV", Content: "60 5060 sip.example.net", TTL: 3600, Priority: 10},
+		{Name: "", Type: "TXT", Content: `v=spf1 "quoted" -all`, TTL: 3600},
+		{Name: "", Type: "ALIAS", Content: "example.net", TTL: 60},
+	}
+
+	rendered := renderZoneFile("example.com", records)
+	assert.Contains(t, rendered, "$ORIGIN example.com.\n")
+	assert.Contains(t, rendered, "@ 3600 IN MX 10 mail.example.com.\n")
+	assert.Contains(t, rendered, "_sip._tcp 3600 IN SRV 10 60 5060 sip.example.net.\n")
+
+	parsed, err := parseZoneFile("example.com", rendered)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, records, parsed)
+}
+
+func TestValidateZoneFileRecord(t *testing.T) {
+	assert.NoError(t, validateZoneFileRecord(dnsimple.ZoneRecord{Type: "MX", Content: "mail.example.com", Priority: 10}))
+	assert.Error(t, validateZoneFileRecord(dnsimple.ZoneRecord{Type: "LOC", Content: "52 22 23.000 N 4 53 32.000 E -2.00m"}))
+	assert.Error(t, validateZoneFileRecord(dnsimple.ZoneRecord{Type: "A", Content: "2001:db8::1"}))
+	assert.Error(t, validateZoneFileRecord(dnsimple.ZoneRecord{Type: "TXT", Content: "text", Priority: 10}))
+}
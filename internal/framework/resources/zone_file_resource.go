@@ -0,0 +1,305 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ZoneFileResource{}
+	_ resource.ResourceWithConfigure      = &ZoneFileResource{}
+	_ resource.ResourceWithImportState    = &ZoneFileResource{}
+	_ resource.ResourceWithValidateConfig = &ZoneFileResource{}
+)
+
+func NewZoneFileResource() resource.Resource {
+	return &ZoneFileResource{}
+}
+
+// ZoneFileResource defines the resource implementation.
+type ZoneFileResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// ZoneFileResourceModel describes the resource data model.
+type ZoneFileResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	ZoneName types.String `tfsdk:"zone_name"`
+	ZoneFile types.String `tfsdk:"zone_file"`
+}
+
+func (r *ZoneFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_file"
+}
+
+func (r *ZoneFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple zone file resource. Authoritatively manages the records of a zone from a zone file in the BIND format.",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDStringAttribute(),
+			"zone_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone_file": schema.StringAttribute{
+				MarkdownDescription: "The records of the zone in the BIND zone file format. Records in the zone that are not part of the file are deleted. The SOA and apex NS records are managed by DNSimple and are ignored.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ZoneFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *ZoneFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ZoneFileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ZoneName.IsUnknown() || data.ZoneName.IsNull() || data.ZoneFile.IsUnknown() || data.ZoneFile.IsNull() {
+		return
+	}
+
+	records, err := zoneFileManagedRecords(data.ZoneName.ValueString(), data.ZoneFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone_file"),
+			"invalid zone file",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, record := range records {
+		err := validateZoneFileRecord(record)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("zone_file"),
+				"invalid zone file",
+				fmt.Sprintf("%s record %q: %s", record.Type, record.Name, err.Error()),
+			)
+		}
+	}
+}
+
+func (r *ZoneFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ZoneFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := zoneFileManagedRecords(data.ZoneName.ValueString(), data.ZoneFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("zone_file"), "invalid zone file", err.Error())
+		return
+	}
+
+	configured, diags := convergeZoneRecords(ctx, r.config, data.ZoneName.ValueString(), true, desired, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.ZoneName
+
+	resp.Diagnostics.Append(setZoneRecordsConfiguredValues(ctx, resp.Private, configured)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ZoneFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured, diags := getZoneRecordsConfiguredValues(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := listZoneRecords(ctx, r.config, data.ZoneName.ValueString(), true)
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			if errorResponse.Response.HTTPResponse.StatusCode == http.StatusNotFound {
+				tflog.Warn(ctx, "removing zone file from state because the zone is not present in the remote")
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Zone File",
+			fmt.Sprintf("Unable to list records of zone '%s': %s", data.ZoneName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	current := presentZoneRecordsConfiguredValues(records, configured)
+
+	// Keep the zone file as written while the zone holds the records it describes,
+	// so that formatting and comments do not show up as a diff.
+	if !zoneFileMatchesRecords(data.ZoneName.ValueString(), data.ZoneFile, records, current) {
+		data.ZoneFile = types.StringValue(renderZoneFile(data.ZoneName.ValueString(), records))
+	}
+
+	data.Id = data.ZoneName
+
+	resp.Diagnostics.Append(setZoneRecordsConfiguredValues(ctx, resp.Private, current)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ZoneFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorConfigured, diags := getZoneRecordsConfiguredValues(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := zoneFileManagedRecords(data.ZoneName.ValueString(), data.ZoneFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("zone_file"), "invalid zone file", err.Error())
+		return
+	}
+
+	configured, diags := convergeZoneRecords(ctx, r.config, data.ZoneName.ValueString(), true, desired, priorConfigured)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.ZoneName
+
+	resp.Diagnostics.Append(setZoneRecordsConfiguredValues(ctx, resp.Private, configured)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ZoneFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteZoneRecords(ctx, r.config, data.ZoneName.ValueString())...)
+}
+
+func (r *ZoneFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), req.ID)...)
+}
+
+// zoneFileManagedRecords parses the zone file and leaves out the SOA and apex
+// NS records, which DNSimple manages as system records.
+func zoneFileManagedRecords(zoneName string, zoneFile string) ([]dnsimple.ZoneRecord, error) {
+	records, err := parseZoneFile(zoneName, zoneFile)
+	if err != nil {
+		return nil, err
+	}
+
+	managed := make([]dnsimple.ZoneRecord, 0, len(records))
+	for _, record := range records {
+		if record.Type == "SOA" || (record.Type == "NS" && record.Name == "") {
+			continue
+		}
+		managed = append(managed, record)
+	}
+
+	return managed, nil
+}
+
+// validateZoneFileRecord checks a record of the zone file as the schema
+// validators check the attributes of a dnsimple_zone_record.
+func validateZoneFileRecord(record dnsimple.ZoneRecord) error {
+	if !slices.Contains(validators.SupportedRecordTypes, record.Type) {
+		return fmt.Errorf("record type %s is not supported by DNSimple", record.Type)
+	}
+	if err := validators.ValidateRecordValue(record.Type, record.Content); err != nil {
+		return err
+	}
+
+	return validators.ValidateRecordPriority(record.Type, int64(record.Priority))
+}
+
+// zoneFileMatchesRecords reports whether the zone file describes exactly the
+// records of the zone.
+func zoneFileMatchesRecords(zoneName string, zoneFile types.String, records []dnsimple.ZoneRecord, configured map[string]zoneRecordConfiguredValue) bool {
+	if zoneFile.IsNull() || zoneFile.IsUnknown() {
+		return false
+	}
+
+	desired, err := zoneFileManagedRecords(zoneName, zoneFile.ValueString())
+	if err != nil {
+		return false
+	}
+
+	changes := diffZoneRecords(desired, records, configured)
+	return len(changes.creates) == 0 && len(changes.updates) == 0 && len(changes.deletes) == 0
+}
@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccZoneFileResource(t *testing.T) {
+	// The resource owns every record of the zone, so use a dedicated zone
+	// rather than the shared acceptance test domain.
+	zoneName := "zone-file-" + os.Getenv("DNSIMPLE_DOMAIN")
+	resourceName := "dnsimple_zone_file.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDomainResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileResourceConfig(zoneName, "192.0.2.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneName),
+					resource.TestCheckResourceAttr(resourceName, "zone_name", zoneName),
+					testAccCheckZoneRecordsCount(zoneName, 3),
+				),
+			},
+			{
+				Config: testAccZoneFileResourceConfig(zoneName, "192.0.2.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsCount(zoneName, 3),
+				),
+			},
+			{
+				// A record added outside of Terraform shows up as drift.
+				PreConfig: func() {
+					testAccCreateZoneRecord(t, zoneName, "drift", "A", "192.0.2.3")
+				},
+				Config:             testAccZoneFileResourceConfig(zoneName, "192.0.2.2"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Applying the configuration again removes it.
+				Config: testAccZoneFileResourceConfig(zoneName, "192.0.2.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsCount(zoneName, 3),
+				),
+			},
+			{
+				// The zone file is imported as rendered from the records of the zone.
+				ResourceName:            resourceName,
+				ImportStateId:           zoneName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccZoneFileResourceConfig(zoneName string, address string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_domain" "test" {
+	name = %[1]q
+}
+
+resource "dnsimple_zone_file" "test" {
+	zone_name = dnsimple_domain.test.name
+
+	zone_file = <<-EOT
+		$TTL 3600
+		; The SOA and apex NS records are managed by DNSimple.
+		@	NS	ns1.dnsimple.com.
+		www	A	%[2]s
+		@	MX	10 mx.example.com.
+		@	TXT	"v=spf1 -all"
+	EOT
+}`, zoneName, address)
+}
@@ -0,0 +1,376 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/modifiers"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ZoneNsRecordsResource{}
+	_ resource.ResourceWithConfigure   = &ZoneNsRecordsResource{}
+	_ resource.ResourceWithImportState = &ZoneNsRecordsResource{}
+	_ resource.ResourceWithModifyPlan  = &ZoneNsRecordsResource{}
+)
+
+// zoneNsRecordsOriginalKey is the private state key of the name servers the
+// zone published before the resource was created, which are restored on
+// destroy.
+const zoneNsRecordsOriginalKey = "original_name_servers"
+
+func NewZoneNsRecordsResource() resource.Resource {
+	return &ZoneNsRecordsResource{}
+}
+
+// ZoneNsRecordsResource defines the resource implementation.
+type ZoneNsRecordsResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// ZoneNsRecordsResourceModel describes the resource data model.
+type ZoneNsRecordsResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ZoneName    types.String `tfsdk:"zone_name"`
+	NameServers types.Set    `tfsdk:"name_servers"`
+}
+
+func (r *ZoneNsRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_ns_records"
+}
+
+func (r *ZoneNsRecordsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple zone NS records resource",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDStringAttribute(),
+			"zone_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_servers": schema.SetAttribute{
+				MarkdownDescription: "The name servers published in the NS records at the apex of the zone. Defaults to the name servers the zone published before the resource was created.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					modifiers.SetTrimSuffixValue(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZoneNsRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+// ModifyPlan plans the name servers the zone published before the resource was
+// created when the name servers are removed from the configuration.
+func (r *ZoneNsRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// On create the current name servers are kept, nothing to do on destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var (
+		config      types.Set
+		plan, state *ZoneNsRecordsResourceModel
+	)
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_servers"), &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !config.IsNull() || !plan.ZoneName.Equal(state.ZoneName) {
+		return
+	}
+
+	original, diags := getZoneNsRecordsOriginal(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An imported resource does not know the name servers to go back to, so
+	// it keeps the current ones.
+	nameServers := state.NameServers
+	if original != nil {
+		nameServers, diags = types.SetValueFrom(ctx, types.StringType, original)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name_servers"), nameServers)...)
+}
+
+func (r *ZoneNsRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ZoneNsRecordsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name servers published before the resource manages them are kept,
+	// so that destroying the resource restores them.
+	records, err := common.ListZoneNsRecords(ctx, r.config.Client, r.config.AccountID, data.ZoneName.ValueString())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to create DNSimple Zone NS Records",
+			fmt.Sprintf("Unable to read the NS records of zone '%s': %s", data.ZoneName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	original := zoneNsRecordsNameServers(records)
+	resp.Diagnostics.Append(setZoneNsRecordsOriginal(ctx, resp.Private, original)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.NameServers.IsUnknown() {
+		data.Id = data.ZoneName
+		resp.Diagnostics.Append(r.updateModelFromAPIResponse(ctx, records, data)...)
+	} else {
+		resp.Diagnostics.Append(r.updateNsRecords(ctx, data, "failed to create DNSimple Zone NS Records")...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "updated zone NS records", map[string]interface{}{"zone_name": data.ZoneName})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneNsRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ZoneNsRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := common.ListZoneNsRecords(ctx, r.config.Client, r.config.AccountID, data.ZoneName.ValueString())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, "removing zone NS records from state because the zone is not present in the remote")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Zone NS Records",
+			fmt.Sprintf("Unable to read the NS records of zone '%s': %s", data.ZoneName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Id = data.ZoneName
+	resp.Diagnostics.Append(r.updateModelFromAPIResponse(ctx, records, data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneNsRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ZoneNsRecordsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateNsRecords(ctx, data, "failed to update DNSimple Zone NS Records")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneNsRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ZoneNsRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	original, diags := getZoneNsRecordsOriginal(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if original == nil {
+		resp.Diagnostics.AddWarning(
+			"the NS records of the zone are left as they are.",
+			fmt.Sprintf("The name servers zone %s published before this resource was imported are not known, so its NS records were not reset.", data.ZoneName.ValueString()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Restoring the NS records of DNSimple Zone %s to %v", data.ZoneName, original))
+
+	_, err := common.UpdateZoneNsRecords(ctx, r.config.Client, r.config.AccountID, data.ZoneName.ValueString(), original)
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to delete DNSimple Zone NS Records",
+			fmt.Sprintf("Unable to restore the NS records of zone '%s': %s", data.ZoneName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, data.ZoneName.ValueString())
+}
+
+func (r *ZoneNsRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), req.ID)...)
+}
+
+// updateNsRecords replaces the NS records of the zone with the planned name
+// servers and updates the model from the new records.
+func (r *ZoneNsRecordsResource) updateNsRecords(ctx context.Context, data *ZoneNsRecordsResourceModel, summary string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	var nameServers []string
+	diagnostics.Append(data.NameServers.ElementsAs(ctx, &nameServers, false)...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	tflog.Debug(ctx, "updating zone NS records", map[string]interface{}{"name servers": nameServers})
+
+	records, err := common.UpdateZoneNsRecords(ctx, r.config.Client, r.config.AccountID, data.ZoneName.ValueString(), nameServers)
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return diagnostics
+		}
+
+		diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Unable to update the NS records of zone '%s': %s", data.ZoneName.ValueString(), err.Error()),
+		)
+		return diagnostics
+	}
+
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, data.ZoneName.ValueString())
+
+	data.Id = data.ZoneName
+	diagnostics.Append(r.updateModelFromAPIResponse(ctx, records, data)...)
+
+	return diagnostics
+}
+
+// updateModelFromAPIResponse sets the name servers from the NS records, with
+// the trailing dots trimmed as they are from the configuration.
+func (r *ZoneNsRecordsResource) updateModelFromAPIResponse(ctx context.Context, records []dnsimple.ZoneRecord, data *ZoneNsRecordsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.NameServers, diags = types.SetValueFrom(ctx, types.StringType, zoneNsRecordsNameServers(records))
+
+	return diags
+}
+
+// zoneNsRecordsNameServers returns the name servers of the NS records, with
+// the trailing dots trimmed.
+func zoneNsRecordsNameServers(records []dnsimple.ZoneRecord) []string {
+	nameServers := make([]string, 0, len(records))
+	for _, record := range records {
+		nameServers = append(nameServers, strings.TrimSuffix(record.Content, "."))
+	}
+
+	return nameServers
+}
+
+// getZoneNsRecordsOriginal returns the name servers to restore on destroy, or
+// nil when they are not known.
+func getZoneNsRecordsOriginal(ctx context.Context, private privateStateGetter) ([]string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, zoneNsRecordsOriginalKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	var original []string
+	if err := json.Unmarshal(value, &original); err != nil {
+		diags.AddError(
+			"failed to read DNSimple Zone NS Records private state",
+			err.Error(),
+		)
+	}
+
+	return original, diags
+}
+
+func setZoneNsRecordsOriginal(ctx context.Context, private privateStateSetter, original []string) diag.Diagnostics {
+	value, err := json.Marshal(original)
+	if err != nil {
+		diags := diag.Diagnostics{}
+		diags.AddError(
+			"failed to write DNSimple Zone NS Records private state",
+			err.Error(),
+		)
+		return diags
+	}
+
+	return private.SetKey(ctx, zoneNsRecordsOriginalKey, value)
+}
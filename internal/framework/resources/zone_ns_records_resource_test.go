@@ -0,0 +1,123 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccZoneNsRecordsResource(t *testing.T) {
+	zoneName := os.Getenv("DNSIMPLE_DOMAIN")
+	resourceName := "dnsimple_zone_ns_records.test"
+
+	// The name servers published before the test are restored on destroy.
+	var original []string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			test_utils.TestAccPreCheck(t)
+
+			var err error
+			original, err = testAccZoneNsRecordsNameServers(zoneName)
+			if err != nil {
+				t.Fatal(err)
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			nameServers, err := testAccZoneNsRecordsNameServers(zoneName)
+			if err != nil {
+				return err
+			}
+			if !slices.Equal(nameServers, original) {
+				return fmt.Errorf("zone NS records were not restored, got %v instead of %v", nameServers, original)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneNsRecordsResourceConfig(zoneName, `["ns1.dnsimple.com", "ns2.dnsimple-edge.net", "ns1.example.net"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneName),
+					resource.TestCheckResourceAttr(resourceName, "name_servers.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "name_servers.*", "ns1.example.net"),
+				),
+			},
+			{
+				// Trailing dots are ignored.
+				Config:   testAccZoneNsRecordsResourceConfig(zoneName, `["ns1.dnsimple.com.", "ns2.dnsimple-edge.net.", "ns1.example.net."]`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     zoneName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Without name servers, the name servers published before the
+				// resource was created are restored.
+				Config: testAccZoneNsRecordsResourceDefaultConfig(zoneName),
+				Check: func(state *terraform.State) error {
+					nameServers, err := testAccZoneNsRecordsNameServers(zoneName)
+					if err != nil {
+						return err
+					}
+					if !slices.Equal(nameServers, original) {
+						return fmt.Errorf("zone NS records were not restored, got %v instead of %v", nameServers, original)
+					}
+					return resource.TestCheckResourceAttr(resourceName, "name_servers.#", strconv.Itoa(len(original)))(state)
+				},
+			},
+			{
+				Config: testAccZoneNsRecordsResourceConfig(zoneName, `["ns1.dnsimple.com", "ns1.example.net"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name_servers.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccZoneNsRecordsNameServers returns the sorted name servers of the NS
+// records at the apex of the zone.
+func testAccZoneNsRecordsNameServers(zoneName string) ([]string, error) {
+	records, err := common.ListZoneNsRecords(context.Background(), dnsimpleClient, testAccAccount, zoneName)
+	if err != nil {
+		return nil, err
+	}
+
+	nameServers := make([]string, 0, len(records))
+	for _, record := range records {
+		nameServers = append(nameServers, strings.TrimSuffix(record.Content, "."))
+	}
+	slices.Sort(nameServers)
+
+	return nameServers, nil
+}
+
+func testAccZoneNsRecordsResourceConfig(zoneName string, nameServers string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_zone_ns_records" "test" {
+	zone_name    = %[1]q
+	name_servers = %[2]s
+}`, zoneName, nameServers)
+}
+
+func testAccZoneNsRecordsResourceDefaultConfig(zoneName string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_zone_ns_records" "test" {
+	zone_name = %[1]q
+}`, zoneName)
+}
@@ -7,8 +7,12 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,9 +29,13 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ZoneRecordResource{}
-	_ resource.ResourceWithConfigure   = &ZoneRecordResource{}
-	_ resource.ResourceWithImportState = &ZoneRecordResource{}
+	_ resource.Resource                   = &ZoneRecordResource{}
+	_ resource.ResourceWithConfigure      = &ZoneRecordResource{}
+	_ resource.ResourceWithImportState    = &ZoneRecordResource{}
+	_ resource.ResourceWithValidateConfig = &ZoneRecordResource{}
+	_ resource.ResourceWithModifyPlan     = &ZoneRecordResource{}
+	_ resource.ResourceWithUpgradeState   = &ZoneRecordResource{}
+	_ resource.ResourceWithMoveState      = &ZoneRecordResource{}
 )
 
 func NewZoneRecordResource() resource.Resource {
@@ -41,25 +49,28 @@ type ZoneRecordResource struct {
 
 // ZoneRecordResourceModel describes the resource data model.
 type ZoneRecordResourceModel struct {
-	ZoneName        types.String `tfsdk:"zone_name"`
-	ZoneId          types.String `tfsdk:"zone_id"`
-	Name            types.String `tfsdk:"name"`
-	NameNormalized  types.String `tfsdk:"name_normalized"`
-	QualifiedName   types.String `tfsdk:"qualified_name"`
-	Type            types.String `tfsdk:"type"`
-	Regions         types.List   `tfsdk:"regions"`
-	Value           types.String `tfsdk:"value"`
-	ValueNormalized types.String `tfsdk:"value_normalized"`
-	TTL             types.Int64  `tfsdk:"ttl"`
-	Priority        types.Int64  `tfsdk:"priority"`
-	Id              types.Int64  `tfsdk:"id"`
+	ZoneName            types.String   `tfsdk:"zone_name"`
+	ZoneId              types.String   `tfsdk:"zone_id"`
+	Name                types.String   `tfsdk:"name"`
+	NameNormalized      types.String   `tfsdk:"name_normalized"`
+	QualifiedName       types.String   `tfsdk:"qualified_name"`
+	Type                types.String   `tfsdk:"type"`
+	Regions             types.List     `tfsdk:"regions"`
+	Value               types.String   `tfsdk:"value"`
+	ValueNormalized     types.String   `tfsdk:"value_normalized"`
+	TXTStrings          types.List     `tfsdk:"txt_strings"`
+	TTL                 types.Int64    `tfsdk:"ttl"`
+	Priority            types.Int64    `tfsdk:"priority"`
+	WaitForDistribution types.Bool     `tfsdk:"wait_for_distribution"`
+	Id                  types.Int64    `tfsdk:"id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ZoneRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_zone_record"
 }
 
-func (r *ZoneRecordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ZoneRecordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "DNSimple domain resource",
@@ -97,11 +108,23 @@ func (r *ZoneRecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType: types.StringType,
 			},
 			"value": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					validators.RecordValue{},
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.RecordValueNormalization(),
+				},
 			},
 			"value_normalized": schema.StringAttribute{
 				Computed: true,
 			},
+			"txt_strings": schema.ListAttribute{
+				MarkdownDescription: "The character strings of a TXT record, as an alternative to `value`. Strings longer than 255 characters are split into several strings.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 			"ttl": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
@@ -112,8 +135,18 @@ func (r *ZoneRecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"priority": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
+				Validators: []validator.Int64{
+					validators.RecordPriority{},
+				},
 			},
-			"id": common.IDInt64Attribute(),
+			"wait_for_distribution": waitForDistributionAttribute("Whether to wait, when the record is created or updated, until the change is distributed to all the DNSimple name servers. The wait is bounded by the `create` and `update` timeouts, 5 minutes by default."),
+			"id":                    common.IDInt64Attribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 		},
 	}
 }
@@ -138,6 +171,83 @@ func (r *ZoneRecordResource) Configure(ctx context.Context, req resource.Configu
 	r.config = config
 }
 
+func (r *ZoneRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ZoneRecordResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Value.IsUnknown() || data.TXTStrings.IsUnknown() {
+		return
+	}
+
+	if data.Value.IsNull() == data.TXTStrings.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid record value",
+			"Exactly one of value or txt_strings must be set.",
+		)
+		return
+	}
+
+	if data.TXTStrings.IsNull() {
+		return
+	}
+
+	if !data.Type.IsUnknown() && data.Type.ValueString() != "TXT" && data.Type.ValueString() != "SPF" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("txt_strings"),
+			"Invalid record value",
+			fmt.Sprintf("txt_strings can only be set on TXT and SPF records, but the record type is %s.", data.Type.ValueString()),
+		)
+		return
+	}
+
+	if len(data.TXTStrings.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("txt_strings"),
+			"Invalid record value",
+			"txt_strings must hold at least one string.",
+		)
+	}
+}
+
+func (r *ZoneRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *ZoneRecordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() || plan.TXTStrings.IsNull() || plan.TXTStrings.IsUnknown() {
+		return
+	}
+
+	var strs []string
+	resp.Diagnostics.Append(plan.TXTStrings.ElementsAs(ctx, &strs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The value of a record set with txt_strings is the serialized strings,
+	// keep the one in state as long as it holds the same strings.
+	value := utils.JoinTXTStrings(strs)
+	if state != nil && modifiers.RecordValuesEqual(plan.Type.ValueString(), value, state.Value.ValueString()) {
+		value = state.Value.ValueString()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), value)...)
+}
+
 func (r *ZoneRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *ZoneRecordResourceModel
 
@@ -153,7 +263,7 @@ func (r *ZoneRecordResource) Create(ctx context.Context, req resource.CreateRequ
 	recordAttributes := dnsimple.ZoneRecordAttributes{
 		Name:    dnsimple.String(data.Name.ValueString()),
 		Type:    data.Type.ValueString(),
-		Content: data.Value.ValueString(),
+		Content: recordContent(data),
 		Regions: regions,
 		TTL:     int(data.TTL.ValueInt64()),
 	}
@@ -166,12 +276,7 @@ func (r *ZoneRecordResource) Create(ctx context.Context, req resource.CreateRequ
 		"attributes": recordAttributes,
 	})
 
-	response, err := r.config.Client.Zones.CreateRecord(
-		ctx,
-		r.config.AccountID,
-		data.ZoneName.ValueString(),
-		recordAttributes,
-	)
+	record, err := r.createRecord(ctx, data.ZoneName.ValueString(), recordAttributes)
 	if err != nil {
 		var errorResponse *dnsimple.ErrorResponse
 		if errors.As(err, &errorResponse) {
@@ -186,12 +291,25 @@ func (r *ZoneRecordResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	r.updateModelFromAPIResponse(response.Data, data)
+	r.config.ZoneRecordCache.Upsert(r.config.AccountID, data.ZoneName.ValueString(), *record)
+	r.updateModelFromAPIResponse(record, data)
 
 	tflog.Info(ctx, "DNSimple Record ID", map[string]interface{}{"id": data.Id})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.WaitForDistribution.ValueBool() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, defaultDistributionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForDistribution(ctx, data, timeout)...)
 }
 
 func (r *ZoneRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -199,6 +317,7 @@ func (r *ZoneRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		data *ZoneRecordResourceModel
 
 		record dnsimple.ZoneRecord
+		found  bool
 
 		skip_prefetch_cache bool = false
 	)
@@ -220,39 +339,27 @@ func (r *ZoneRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if r.config.Prefetch && !skip_prefetch_cache {
-		if _, ok := r.config.ZoneRecordCache.Get(data.ZoneName.ValueString()); !ok {
-			err := r.config.ZoneRecordCache.Hydrate(ctx, r.config.Client, r.config.AccountID, data.ZoneName.ValueString(), nil)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"failed to hydrate zone record cache",
-					err.Error(),
-				)
-				return
-			}
-		}
-
-		var lookupName string
-		if data.NameNormalized.IsNull() || data.NameNormalized.IsUnknown() {
-			lookupName = data.Name.ValueString()
-		} else {
-			lookupName = data.NameNormalized.ValueString()
-		}
-
-		cacheRecord, ok := r.config.ZoneRecordCache.Find(data.ZoneName.ValueString(), lookupName, data.Type.ValueString(), data.ValueNormalized.ValueString())
-		if !ok {
+		err := r.config.ZoneRecordCache.Hydrate(ctx, r.config.Client, r.config.AccountID, data.ZoneName.ValueString(), nil)
+		if err != nil {
 			resp.Diagnostics.AddError(
-				"failed to read DNSimple Zone Record",
-				fmt.Sprintf("Zone record not found in cache for qualified name '%s'", data.QualifiedName.ValueString()),
+				"failed to hydrate zone record cache",
+				err.Error(),
 			)
 			return
 		}
 
-		tflog.Debug(ctx, "DNSimple Zone Record cache hit", map[string]interface{}{
-			"zone_name": data.ZoneName.ValueString(),
-		})
+		record, found = r.config.ZoneRecordCache.FindByID(r.config.AccountID, data.ZoneName.ValueString(), data.Id.ValueInt64())
+		if found {
+			tflog.Debug(ctx, "DNSimple Zone Record cache hit", map[string]interface{}{
+				"zone_name": data.ZoneName.ValueString(),
+			})
+		}
+	}
 
-		record = cacheRecord
-	} else {
+	// The record is looked up by ID when it is not in the cache, so that a
+	// record the cache missed is only removed from state once the API confirms
+	// it is gone.
+	if !found {
 		tflog.Debug(ctx, "DNSimple Zone Record cache miss", map[string]interface{}{
 			"zone_name": data.ZoneName.ValueString(),
 		})
@@ -276,9 +383,10 @@ func (r *ZoneRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		}
 
 		record = *response.Data
+		r.config.ZoneRecordCache.Upsert(r.config.AccountID, data.ZoneName.ValueString(), record)
 	}
 
-	if record.Content != data.ValueNormalized.ValueString() {
+	if record.Content != data.ValueNormalized.ValueString() && !modifiers.RecordValuesEqual(record.Type, record.Content, data.Value.ValueString()) {
 		// If the record content has changed, we need to update the record in the remote
 		tflog.Debug(ctx, "DNSimple Zone Record content changed")
 		data.Value = types.StringValue(record.Content)
@@ -286,6 +394,16 @@ func (r *ZoneRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	r.updateModelFromAPIResponse(&record, data)
 
+	// Records created before wait_for_distribution existed, or imported,
+	// have no value for it in state.
+	if data.WaitForDistribution.IsNull() {
+		data.WaitForDistribution = types.BoolValue(false)
+	}
+
+	if !data.TXTStrings.IsNull() {
+		resp.Diagnostics.Append(r.updateTXTStringsFromAPIResponse(ctx, &record, data)...)
+	}
+
 	// Clear the private key to avoid reusing it in the next request after the import
 	if skip_prefetch_cache {
 		resp.Private.SetKey(ctx, "skip_prefetch_cache", nil)
@@ -310,7 +428,7 @@ func (r *ZoneRecordResource) Update(ctx context.Context, req resource.UpdateRequ
 	recordAttributes := dnsimple.ZoneRecordAttributes{
 		Name:    dnsimple.String(data.Name.ValueString()),
 		Type:    data.Type.ValueString(),
-		Content: data.Value.ValueString(),
+		Content: recordContent(data),
 		Regions: regions,
 		TTL:     int(data.TTL.ValueInt64()),
 	}
@@ -321,13 +439,7 @@ func (r *ZoneRecordResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	tflog.Debug(ctx, fmt.Sprintf("DNSimple Zone Record updateRecordAttributes: %+v", recordAttributes))
 
-	response, err := r.config.Client.Zones.UpdateRecord(
-		ctx,
-		r.config.AccountID,
-		data.ZoneName.ValueString(),
-		data.Id.ValueInt64(),
-		recordAttributes,
-	)
+	record, err := r.updateRecord(ctx, data.ZoneName.ValueString(), data.Id.ValueInt64(), recordAttributes)
 	if err != nil {
 		var errorResponse *dnsimple.ErrorResponse
 		if errors.As(err, &errorResponse) {
@@ -344,9 +456,22 @@ func (r *ZoneRecordResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	r.updateModelFromAPIResponse(response.Data, data)
+	r.config.ZoneRecordCache.Upsert(r.config.AccountID, data.ZoneName.ValueString(), *record)
+	r.updateModelFromAPIResponse(record, data)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.WaitForDistribution.ValueBool() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Update(ctx, defaultDistributionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForDistribution(ctx, data, timeout)...)
 }
 
 func (r *ZoneRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -361,7 +486,7 @@ func (r *ZoneRecordResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting DNSimple Record: %s, %d", data.ZoneName, data.Id))
 
-	_, err := r.config.Client.Zones.DeleteRecord(ctx, r.config.AccountID, data.ZoneName.ValueString(), data.Id.ValueInt64())
+	err := r.deleteRecord(ctx, data.ZoneName.ValueString(), data.Id.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"failed to delete DNSimple Zone Record",
@@ -369,33 +494,313 @@ func (r *ZoneRecordResource) Delete(ctx context.Context, req resource.DeleteRequ
 		)
 		return
 	}
+
+	r.config.ZoneRecordCache.Remove(r.config.AccountID, data.ZoneName.ValueString(), data.Id.ValueInt64())
 }
 
 func (r *ZoneRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "_")
-	if len(parts) != 2 {
+	importID, err := parseZoneRecordImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid import ID", err.Error())
+		return
+	}
+
+	if importID.id == 0 {
+		record, err := r.findRecordForImport(ctx, importID)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to import DNSimple Zone Record", err.Error())
+			return
+		}
+
+		importID.id = record.ID
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), importID.name)...)
+	}
+
+	resp.Private.SetKey(ctx, "skip_prefetch_cache", []byte(`true`))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID.id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), importID.zoneName)...)
+}
+
+// zoneRecordImportID identifies the record to import, either by ID or by
+// name, type and optionally content.
+type zoneRecordImportID struct {
+	zoneName   string
+	id         int64
+	name       string
+	recordType string
+	content    string
+}
+
+// UpgradeState upgrades the state of the resource from the previous schema
+// versions.
+func (r *ZoneRecordResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeZoneRecordStateV0},
+	}
+}
+
+// upgradeZoneRecordStateV0 upgrades the state written by the plugin SDK
+// versions of the resource, where the ID, TTL and priority were strings, and
+// by the provider versions prior to the normalized name and value.
+func upgradeZoneRecordStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	prior, err := newRawState(req.RawState)
+	if err != nil {
+		resp.Diagnostics.Append(stateUpgradeErrorDiagnostics("dnsimple_zone_record", 0, err)...)
+		return
+	}
+
+	data := zoneRecordModelFromRawState(prior)
+	if err := prior.Err(); err != nil {
+		resp.Diagnostics.Append(stateUpgradeErrorDiagnostics("dnsimple_zone_record", 0, err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: moveZoneRecordStateFromRecord},
+	}
+}
+
+// moveZoneRecordStateFromRecord moves the state of the removed dnsimple_record
+// resource, which named the zone domain and the qualified name hostname.
+func moveZoneRecordStateFromRecord(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	// Leave other sources to the remaining movers, or to the error reported
+	// by Terraform when none applies.
+	if req.SourceTypeName != "dnsimple_record" || !strings.HasSuffix(req.SourceProviderAddress, "/dnsimple") {
+		return
+	}
+
+	prior, err := newRawState(req.SourceRawState)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"invalid import ID",
-			fmt.Sprintf("Invalid import ID format '%s'. Expected format: '<zone-name>_<record-id>'", req.ID),
+			"Unable to Move Resource State",
+			fmt.Sprintf("Unable to move the dnsimple_record state to dnsimple_zone_record: %s", err.Error()),
 		)
 		return
 	}
-	zoneName := parts[0]
-	recordID := parts[1]
 
-	id, err := strconv.ParseInt(recordID, 10, 64)
-	if err != nil {
+	prior.Rename("domain", "zone_name")
+	prior.Rename("domain_id", "zone_id")
+	prior.Rename("hostname", "qualified_name")
+
+	data := zoneRecordModelFromRawState(prior)
+	if err := prior.Err(); err != nil {
 		resp.Diagnostics.AddError(
-			"invalid import ID",
-			fmt.Sprintf("Unable to parse record ID '%s' as integer. Expected a numeric ID", recordID),
+			"Unable to Move Resource State",
+			fmt.Sprintf("Unable to move the dnsimple_record state to dnsimple_zone_record: %s", err.Error()),
 		)
 		return
 	}
 
-	resp.Private.SetKey(ctx, "skip_prefetch_cache", []byte(`true`))
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+}
+
+// zoneRecordModelFromRawState reads a zone record from a prior state, which
+// may have been written by the plugin SDK versions of the provider.
+func zoneRecordModelFromRawState(prior *rawState) ZoneRecordResourceModel {
+	data := ZoneRecordResourceModel{
+		ZoneName:            prior.String("zone_name"),
+		ZoneId:              prior.String("zone_id"),
+		Name:                prior.String("name"),
+		NameNormalized:      prior.String("name_normalized"),
+		QualifiedName:       prior.String("qualified_name"),
+		Type:                prior.String("type"),
+		Regions:             prior.StringList("regions"),
+		Value:               prior.String("value"),
+		ValueNormalized:     prior.String("value_normalized"),
+		TXTStrings:          types.ListNull(types.StringType),
+		TTL:                 prior.Int64("ttl"),
+		Priority:            prior.Int64("priority"),
+		WaitForDistribution: types.BoolValue(false),
+		Id:                  prior.Int64("id"),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+			}),
+		},
+	}
+
+	// The record was written as configured before the normalized values
+	// existed. They are refreshed from the API right after the upgrade.
+	if !prior.Has("name_normalized") {
+		data.NameNormalized = data.Name
+	}
+	if !prior.Has("value_normalized") {
+		data.ValueNormalized = data.Value
+	}
+
+	return data
+}
+
+// parseZoneRecordImportID parses an import ID given either as
+// <zone-name>_<record-id> or as <zone-name>/<name>/<type>[/<content>].
+func parseZoneRecordImportID(id string) (zoneRecordImportID, error) {
+	if strings.Contains(id, "/") {
+		parts := strings.SplitN(id, "/", 4)
+		if len(parts) < 3 || parts[0] == "" || parts[2] == "" {
+			return zoneRecordImportID{}, fmt.Errorf("Invalid import ID format '%s'. Expected format: '<zone-name>/<record-name>/<record-type>[/<record-value>]'", id)
+		}
+
+		importID := zoneRecordImportID{
+			zoneName:   parts[0],
+			name:       parts[1],
+			recordType: strings.ToUpper(parts[2]),
+		}
+		if len(parts) == 4 {
+			importID.content = parts[3]
+		}
+
+		return importID, nil
+	}
+
+	// Record names such as _dmarc hold underscores, the record ID is whatever
+	// follows the last one.
+	separator := strings.LastIndex(id, "_")
+	if separator <= 0 {
+		return zoneRecordImportID{}, fmt.Errorf("Invalid import ID format '%s'. Expected format: '<zone-name>_<record-id>' or '<zone-name>/<record-name>/<record-type>[/<record-value>]'", id)
+	}
+
+	recordID, err := strconv.ParseInt(id[separator+1:], 10, 64)
+	if err != nil {
+		return zoneRecordImportID{}, fmt.Errorf("Unable to parse record ID '%s' as integer. Expected a numeric ID", id[separator+1:])
+	}
+
+	return zoneRecordImportID{zoneName: id[:separator], id: recordID}, nil
+}
+
+// findRecordForImport looks up the single record matching the name, type and
+// content of the import ID.
+func (r *ZoneRecordResource) findRecordForImport(ctx context.Context, importID zoneRecordImportID) (*dnsimple.ZoneRecord, error) {
+	records, err := common.ListAllZoneRecords(ctx, r.config.Client, r.config.AccountID, importID.zoneName, &dnsimple.ZoneRecordListOptions{
+		Name: dnsimple.String(normalizeZoneRecordName(importID.name)),
+		Type: dnsimple.String(importID.recordType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list records of zone '%s': %s", importID.zoneName, err.Error())
+	}
+
+	var matches []dnsimple.ZoneRecord
+	for _, record := range records {
+		if record.Name != normalizeZoneRecordName(importID.name) {
+			continue
+		}
+		if importID.content != "" && !modifiers.RecordValuesEqual(record.Type, record.Content, importID.content) {
+			continue
+		}
+		matches = append(matches, record)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("No %s record named '%s' found in zone '%s'", importID.recordType, importID.name, importID.zoneName)
+	case 1:
+		return &matches[0], nil
+	}
+
+	candidates := make([]string, 0, len(matches))
+	for _, record := range matches {
+		candidates = append(candidates, fmt.Sprintf("%s_%d (%s)", importID.zoneName, record.ID, record.Content))
+	}
+
+	return nil, fmt.Errorf("Found %d %s records named '%s' in zone '%s'. Add the record value to the import ID as '%s/%s/%s/<record-value>', or import by ID using one of: %s",
+		len(matches), importID.recordType, importID.name, importID.zoneName, importID.zoneName, importID.name, importID.recordType, strings.Join(candidates, ", "))
+}
+
+// recordContent returns the content to send to the API for the record.
+func recordContent(data *ZoneRecordResourceModel) string {
+	content := data.Value.ValueString()
+	if data.Type.ValueString() == "TXT" || data.Type.ValueString() == "SPF" {
+		content = utils.ChunkTXTContent(content)
+	}
+
+	return content
+}
+
+// updateTXTStringsFromAPIResponse sets txt_strings from the content of the
+// record, unless the content holds the strings in state.
+func (r *ZoneRecordResource) updateTXTStringsFromAPIResponse(ctx context.Context, record *dnsimple.ZoneRecord, data *ZoneRecordResourceModel) diag.Diagnostics {
+	var strs []string
+	diags := data.TXTStrings.ElementsAs(ctx, &strs, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	if modifiers.RecordValuesEqual(record.Type, record.Content, utils.JoinTXTStrings(strs)) {
+		return diags
+	}
+
+	strs, ok := utils.SplitTXTStrings(record.Content)
+	if !ok {
+		strs = []string{record.Content}
+	}
+
+	txtStrings, listDiags := types.ListValueFrom(ctx, types.StringType, strs)
+	diags.Append(listDiags...)
+	data.TXTStrings = txtStrings
+
+	return diags
+}
+
+// createRecord creates the record, as part of a batch of changes of the zone
+// when zone record changes are batched.
+func (r *ZoneRecordResource) createRecord(ctx context.Context, zoneName string, attributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecord, error) {
+	if r.config.ZoneRecordBatcher != nil {
+		return r.config.ZoneRecordBatcher.CreateRecord(ctx, r.config.AccountID, zoneName, attributes)
+	}
+
+	response, err := r.config.Client.Zones.CreateRecord(ctx, r.config.AccountID, zoneName, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// updateRecord updates the record, as part of a batch of changes of the zone
+// when zone record changes are batched.
+func (r *ZoneRecordResource) updateRecord(ctx context.Context, zoneName string, recordID int64, attributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecord, error) {
+	if r.config.ZoneRecordBatcher != nil {
+		return r.config.ZoneRecordBatcher.UpdateRecord(ctx, r.config.AccountID, zoneName, recordID, attributes)
+	}
+
+	response, err := r.config.Client.Zones.UpdateRecord(ctx, r.config.AccountID, zoneName, recordID, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// deleteRecord deletes the record, as part of a batch of changes of the zone
+// when zone record changes are batched.
+func (r *ZoneRecordResource) deleteRecord(ctx context.Context, zoneName string, recordID int64) error {
+	if r.config.ZoneRecordBatcher != nil {
+		return r.config.ZoneRecordBatcher.DeleteRecord(ctx, r.config.AccountID, zoneName, recordID)
+	}
+
+	_, err := r.config.Client.Zones.DeleteRecord(ctx, r.config.AccountID, zoneName, recordID)
+	return err
+}
+
+// waitForDistribution waits until the record is distributed to all the
+// DNSimple name servers.
+func (r *ZoneRecordResource) waitForDistribution(ctx context.Context, data *ZoneRecordResourceModel, timeout time.Duration) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	err := waitForZoneRecordDistribution(ctx, r.config, data.ZoneName.ValueString(), data.Id.ValueInt64(), timeout)
+	if err != nil {
+		diagnostics.AddError(
+			"failed to wait for DNSimple Zone Record distribution",
+			fmt.Sprintf("Zone record '%s' (ID: %d) was saved but is not distributed yet: %s", data.Name.ValueString(), data.Id.ValueInt64(), err.Error()),
+		)
+	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), zoneName)...)
+	return diagnostics
 }
 
 func (r *ZoneRecordResource) updateModelFromAPIResponse(record *dnsimple.ZoneRecord, data *ZoneRecordResourceModel) {
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseZoneRecordImportID(t *testing.T) {
+	tests := map[string]zoneRecordImportID{
+		"example.com_1234":                     {zoneName: "example.com", id: 1234},
+		"my_zone.example.com_1234":             {zoneName: "my_zone.example.com", id: 1234},
+		"example.com/www/A":                    {zoneName: "example.com", name: "www", recordType: "A"},
+		"example.com//MX":                      {zoneName: "example.com", name: "", recordType: "MX"},
+		"example.com/@/TXT/v=spf1 -all":        {zoneName: "example.com", name: "@", recordType: "TXT", content: "v=spf1 -all"},
+		"example.com/_dmarc/TXT":               {zoneName: "example.com", name: "_dmarc", recordType: "TXT"},
+		"example.com/go/URL/https://a.b/c/d":   {zoneName: "example.com", name: "go", recordType: "URL", content: "https://a.b/c/d"},
+		"example.com/_acme-challenge.www/TXT/": {zoneName: "example.com", name: "_acme-challenge.www", recordType: "TXT"},
+		"example.com/www/cname":                {zoneName: "example.com", name: "www", recordType: "CNAME"},
+	}
+
+	for id, expected := range tests {
+		importID, err := parseZoneRecordImportID(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, importID, id)
+	}
+}
+
+func TestParseZoneRecordImportID_Invalid(t *testing.T) {
+	for _, id := range []string{
+		"example.com",
+		"example.com_abc",
+		"_1234",
+		"example.com/www",
+		"/www/A",
+		"example.com/www/",
+	} {
+		_, err := parseZoneRecordImportID(id)
+		assert.Error(t, err, id)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
@@ -64,6 +66,46 @@ func TestAccZoneRecordResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     domainName + "/terraform/A",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     domainName + "/terraform/A/192.168.0.12",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportStateId: domainName + "/terraform/A/192.168.0.99",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("No A record named 'terraform' found"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneRecordResourceImportAmbiguous(t *testing.T) {
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordResourceSameNameConfig(domainName),
+			},
+			{
+				ResourceName:  "dnsimple_zone_record.first",
+				ImportStateId: domainName + "/terraform/A",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Found 2 A records named 'terraform'"),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -141,6 +183,76 @@ func TestAccZoneRecordResourceWithNormalizedTXT(t *testing.T) {
 	})
 }
 
+func TestAccZoneRecordResourceWithEquivalentValue(t *testing.T) {
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+	resourceName := "dnsimple_zone_record.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordResourceTXTConfig(domainName, "v=spf1 -all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "v=spf1 -all"),
+				),
+			},
+			{
+				// Quoting the TXT value does not change the record, so there is nothing to plan.
+				Config:   testAccZoneRecordResourceTXTConfig(domainName, "\"v=spf1 -all\""),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccZoneRecordResourceTXTConfig(domainName, "v=spf1 ~all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "v=spf1 ~all"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneRecordResourceWithTXTStrings(t *testing.T) {
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+	resourceName := "dnsimple_zone_record.test"
+	dkimKey := strings.Repeat("MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A", 10)
+	dkimRecord := "v=DKIM1; k=rsa; p=" + dkimKey
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordResourceTXTStringsConfig(domainName, []string{dkimRecord}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "txt_strings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "txt_strings.0", dkimRecord),
+					resource.TestCheckResourceAttr(resourceName, "value", fmt.Sprintf("%q %q", dkimRecord[:255], dkimRecord[255:])),
+				),
+			},
+			{
+				Config: testAccZoneRecordResourceTXTStringsConfig(domainName, []string{"v=DKIM1; k=rsa; ", "p=" + dkimKey[:100]}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "txt_strings.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "value", fmt.Sprintf("%q %q", "v=DKIM1; k=rsa; ", "p="+dkimKey[:100])),
+				),
+			},
+			{
+				// A long value is split into strings of 255 characters, and reads back without a difference.
+				Config: testAccZoneRecordResourceTXTConfig(domainName, dkimRecord),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", dkimRecord),
+					resource.TestCheckNoResourceAttr(resourceName, "txt_strings"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccZoneRecordResourceWithNormalizedName(t *testing.T) {
 	domainName := os.Getenv("DNSIMPLE_DOMAIN")
 	resourceName := "dnsimple_zone_record.test"
@@ -219,6 +331,124 @@ func TestAccZoneRecordResource_Prefetch_Normalized(t *testing.T) {
 	})
 }
 
+func TestAccZoneRecordResource_Prefetch_Drift(t *testing.T) {
+	var record dnsimple.ZoneRecord
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+	resourceName := "dnsimple_zone_record.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			test_utils.TestAccPreCheck(t)
+			t.Setenv("DNSIMPLE_PREFETCH", "1")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordResourcePriorityConfig(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordExists(resourceName, &record),
+				),
+			},
+			{
+				// A record whose content changed outside of Terraform is
+				// still found by ID and shows up as drift.
+				PreConfig: func() {
+					_, err := dnsimpleClient.Zones.UpdateRecord(context.Background(), testAccAccount, domainName, record.ID, dnsimple.ZoneRecordAttributes{Content: "mail2.example.com"})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccZoneRecordResourcePriorityConfig(domainName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccZoneRecordResourcePriorityConfig(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "mail.example.com"),
+				),
+			},
+			{
+				// A record deleted outside of Terraform is removed from state.
+				PreConfig: func() {
+					_, err := dnsimpleClient.Zones.DeleteRecord(context.Background(), testAccAccount, domainName, record.ID)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccZoneRecordResourcePriorityConfig(domainName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneRecordResourceWithBatchRecordChanges(t *testing.T) {
+	var recordSet1 dnsimple.ZoneRecord
+	var recordSet2 dnsimple.ZoneRecord
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			test_utils.TestAccPreCheck(t)
+			t.Setenv("DNSIMPLE_BATCH_RECORD_CHANGES", "1")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Both records are created in the same batch, and each maps back to its own state.
+				Config: testAccZoneRecordResourceMultipleConfig(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordExists("dnsimple_zone_record.for_each_test_1", &recordSet1),
+					testAccCheckZoneRecordExists("dnsimple_zone_record.for_each_test_2", &recordSet2),
+					resource.TestCheckResourceAttr("dnsimple_zone_record.for_each_test_1", "name", ""),
+					resource.TestCheckResourceAttr("dnsimple_zone_record.for_each_test_2", "name", "1a"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneRecordResourceWithWaitForDistribution(t *testing.T) {
+	domainName := os.Getenv("DNSIMPLE_DOMAIN")
+	resourceName := "dnsimple_zone_record.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordResourceWaitForDistributionConfig(domainName, "192.168.0.20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_distribution", "true"),
+					resource.TestCheckResourceAttr(resourceName, "value", "192.168.0.20"),
+				),
+			},
+			{
+				Config: testAccZoneRecordResourceWaitForDistributionConfig(domainName, "192.168.0.21"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_distribution", "true"),
+					resource.TestCheckResourceAttr(resourceName, "value", "192.168.0.21"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccZoneRecordImportStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_distribution", "timeouts"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccZoneRecordResource_Prefetch_ForEach(t *testing.T) {
 	// Issue: https://github.com/dnsimple/terraform-provider-dnsimple/issues/80
 	// This test is to ensure that the prefetch behaviour is deterministic
@@ -354,6 +584,19 @@ resource "dnsimple_zone_record" "test" {
 }`, domainName, value)
 }
 
+func testAccZoneRecordResourceTXTStringsConfig(domainName string, strs []string) string {
+	serialized, _ := json.Marshal(strs)
+
+	return fmt.Sprintf(`
+resource "dnsimple_zone_record" "test" {
+	zone_name = %[1]q
+
+	name = "terraform._domainkey"
+	txt_strings = %[2]s
+	type = "TXT"
+}`, domainName, serialized)
+}
+
 func testAccZoneRecordResourceNormalizedNameConfig(domainName string) string {
 	return fmt.Sprintf(`
 resource "dnsimple_zone_record" "test" {
@@ -448,3 +691,41 @@ resource "dnsimple_zone_record" "for_each_test_2" {
 	ttl       = 600
 }`, domainName)
 }
+
+func testAccZoneRecordResourceSameNameConfig(domainName string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_zone_record" "first" {
+	zone_name = %[1]q
+
+	name  = "terraform"
+	value = "192.168.0.20"
+	type  = "A"
+}
+
+resource "dnsimple_zone_record" "second" {
+	zone_name = %[1]q
+
+	name  = "terraform"
+	value = "192.168.0.21"
+	type  = "A"
+}`, domainName)
+}
+
+func testAccZoneRecordResourceWaitForDistributionConfig(domainName string, value string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_zone_record" "test" {
+	zone_name = %[1]q
+
+	name = "terraform-distribution"
+	value = %[2]q
+	type = "A"
+	ttl = 60
+
+	wait_for_distribution = true
+
+	timeouts {
+		create = "2m"
+		update = "2m"
+	}
+}`, domainName, value)
+}
@@ -0,0 +1,879 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/modifiers"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ZoneRecordsResource{}
+	_ resource.ResourceWithConfigure   = &ZoneRecordsResource{}
+	_ resource.ResourceWithImportState = &ZoneRecordsResource{}
+)
+
+// zoneRecordsConfiguredValuesKey is the private state key holding the configured
+// name and value of each record, indexed by the record as the API returned it.
+const zoneRecordsConfiguredValuesKey = "configured_values"
+
+func NewZoneRecordsResource() resource.Resource {
+	return &ZoneRecordsResource{}
+}
+
+// ZoneRecordsResource defines the resource implementation.
+type ZoneRecordsResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// ZoneRecordsResourceModel describes the resource data model.
+type ZoneRecordsResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	ZoneName            types.String `tfsdk:"zone_name"`
+	IgnoreSystemRecords types.Bool   `tfsdk:"ignore_system_records"`
+	Records             types.Set    `tfsdk:"records"`
+}
+
+// ZoneRecordsRecordModel describes a single record of the record set.
+type ZoneRecordsRecordModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Value    types.String `tfsdk:"value"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Regions  types.List   `tfsdk:"regions"`
+}
+
+var ZoneRecordsRecordAttrType = map[string]attr.Type{
+	"name":     types.StringType,
+	"type":     types.StringType,
+	"value":    types.StringType,
+	"ttl":      types.Int64Type,
+	"priority": types.Int64Type,
+	"regions": types.ListType{
+		ElemType: types.StringType,
+	},
+}
+
+// zoneRecordConfiguredValue is what the practitioner wrote for a record whose
+// name or content the API normalized, e.g. "@" for the apex or unquoted TXT content.
+type zoneRecordConfiguredValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// privateStateGetter and privateStateSetter are satisfied by the private state
+// carried on the framework requests and responses.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// zoneRecordChange pairs a desired record with the existing record it converges.
+type zoneRecordChange struct {
+	desired  dnsimple.ZoneRecord
+	existing dnsimple.ZoneRecord
+}
+
+// zoneRecordsChanges holds the calls needed to converge a zone onto the desired record set.
+type zoneRecordsChanges struct {
+	creates   []zoneRecordChange
+	updates   []zoneRecordChange
+	deletes   []zoneRecordChange
+	unchanged []zoneRecordChange
+}
+
+func (r *ZoneRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_records"
+}
+
+func (r *ZoneRecordsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSimple zone records resource. Authoritatively manages the full record set of a zone.",
+		Attributes: map[string]schema.Attribute{
+			"id": common.IDStringAttribute(),
+			"zone_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ignore_system_records": schema.BoolAttribute{
+				MarkdownDescription: "Whether to leave records managed by DNSimple, such as the SOA and apex NS records, out of the record set. Defaults to `true`. When set to `false`, the system records declared in `records` are matched against the zone instead of created, and the others are left out of the record set.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"records": schema.SetNestedAttribute{
+				MarkdownDescription: "The complete set of records of the zone. Records in the zone that are not part of this set are deleted.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								validators.RecordType{},
+							},
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								validators.RecordValue{},
+							},
+						},
+						"ttl": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(3600),
+						},
+						"priority": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(0),
+							Validators: []validator.Int64{
+								validators.RecordPriority{},
+							},
+						},
+						"regions": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ZoneRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *ZoneRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ZoneRecordsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := r.desiredRecords(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured, diags := convergeZoneRecords(ctx, r.config, data.ZoneName.ValueString(), data.IgnoreSystemRecords.ValueBool(), desired, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.ZoneName
+
+	resp.Diagnostics.Append(setZoneRecordsConfiguredValues(ctx, resp.Private, configured)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ZoneRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ignore_system_records is not set during an import, settle it to the schema default.
+	if data.IgnoreSystemRecords.IsNull() || data.IgnoreSystemRecords.IsUnknown() {
+		data.IgnoreSystemRecords = types.BoolValue(true)
+	}
+
+	configured, diags := getZoneRecordsConfiguredValues(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := listZoneRecords(ctx, r.config, data.ZoneName.ValueString(), data.IgnoreSystemRecords.ValueBool())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			if errorResponse.Response.HTTPResponse.StatusCode == http.StatusNotFound {
+				tflog.Warn(ctx, "removing zone records from state because the zone is not present in the remote")
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"failed to read DNSimple Zone Records",
+			fmt.Sprintf("Unable to list records of zone '%s': %s", data.ZoneName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if !data.IgnoreSystemRecords.ValueBool() {
+		records = declaredZoneRecords(records, configured)
+	}
+
+	current := presentZoneRecordsConfiguredValues(records, configured)
+
+	resp.Diagnostics.Append(r.updateModelFromAPIResponse(ctx, records, current, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.ZoneName
+
+	resp.Diagnostics.Append(setZoneRecordsConfiguredValues(ctx, resp.Private, current)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ZoneRecordsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorConfigured, diags := getZoneRecordsConfiguredValues(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := r.desiredRecords(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured, diags := convergeZoneRecords(ctx, r.config, data.ZoneName.ValueString(), data.IgnoreSystemRecords.ValueBool(), desired, priorConfigured)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.ZoneName
+
+	resp.Diagnostics.Append(setZoneRecordsConfiguredValues(ctx, resp.Private, configured)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ZoneRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteZoneRecords(ctx, r.config, data.ZoneName.ValueString())...)
+}
+
+func (r *ZoneRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ignore_system_records"), types.BoolValue(true))...)
+}
+
+// convergeZoneRecords applies the changes needed for the zone to hold exactly
+// the desired records, and returns the configured values to keep in private state.
+func convergeZoneRecords(ctx context.Context, config *common.DnsimpleProviderConfig, zoneName string, ignoreSystemRecords bool, desired []dnsimple.ZoneRecord, priorConfigured map[string]zoneRecordConfiguredValue) (map[string]zoneRecordConfiguredValue, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+
+	existing, err := listZoneRecords(ctx, config, zoneName, ignoreSystemRecords)
+	if err != nil {
+		diagnostics.AddError(
+			"failed to read DNSimple Zone Records",
+			fmt.Sprintf("Unable to list records of zone '%s': %s", zoneName, err.Error()),
+		)
+		return nil, diagnostics
+	}
+
+	changes := diffZoneRecords(desired, existing, priorConfigured)
+
+	tflog.Debug(ctx, "DNSimple Zone Records changes", map[string]interface{}{
+		"zone_name": zoneName,
+		"creates":   len(changes.creates),
+		"updates":   len(changes.updates),
+		"deletes":   len(changes.deletes),
+	})
+
+	configured := make(map[string]zoneRecordConfiguredValue, len(desired))
+
+	for _, change := range changes.unchanged {
+		configured[zoneRecordKey(change.existing.Name, change.existing.Type, change.existing.Content)] = zoneRecordConfiguredValue{
+			Name:  change.desired.Name,
+			Value: change.desired.Content,
+		}
+	}
+
+	if config.ZoneRecordBatcher != nil {
+		diagnostics.Append(batchZoneRecordsChanges(ctx, config, zoneName, changes, configured)...)
+		if diagnostics.HasError() {
+			return nil, diagnostics
+		}
+
+		return configured, diagnostics
+	}
+
+	// Delete first so that replacing e.g. a CNAME does not conflict with the record it replaces.
+	for _, change := range changes.deletes {
+		tflog.Info(ctx, fmt.Sprintf("Deleting DNSimple Record: %s, %d", zoneName, change.existing.ID))
+
+		_, err := config.Client.Zones.DeleteRecord(ctx, config.AccountID, zoneName, change.existing.ID)
+		if err != nil {
+			diagnostics.AddError(
+				"failed to delete DNSimple Zone Record",
+				fmt.Sprintf("Unable to delete zone record '%s' (ID: %d): %s", change.existing.Name, change.existing.ID, err.Error()),
+			)
+			return nil, diagnostics
+		}
+		config.ZoneRecordCache.Remove(config.AccountID, zoneName, change.existing.ID)
+	}
+
+	for _, change := range changes.updates {
+		response, err := config.Client.Zones.UpdateRecord(ctx, config.AccountID, zoneName, change.existing.ID, zoneRecordAttributes(change.desired))
+		if err != nil {
+			diagnostics.Append(zoneRecordsAPIErrorToDiagnostics(err, "failed to update DNSimple Zone Record")...)
+			return nil, diagnostics
+		}
+		config.ZoneRecordCache.Upsert(config.AccountID, zoneName, *response.Data)
+
+		configured[zoneRecordKey(response.Data.Name, response.Data.Type, response.Data.Content)] = zoneRecordConfiguredValue{
+			Name:  change.desired.Name,
+			Value: change.desired.Content,
+		}
+	}
+
+	for _, change := range changes.creates {
+		response, err := config.Client.Zones.CreateRecord(ctx, config.AccountID, zoneName, zoneRecordAttributes(change.desired))
+		if err != nil {
+			diagnostics.Append(zoneRecordsAPIErrorToDiagnostics(err, "failed to create DNSimple Zone Record")...)
+			return nil, diagnostics
+		}
+		config.ZoneRecordCache.Upsert(config.AccountID, zoneName, *response.Data)
+
+		configured[zoneRecordKey(response.Data.Name, response.Data.Type, response.Data.Content)] = zoneRecordConfiguredValue{
+			Name:  change.desired.Name,
+			Value: change.desired.Content,
+		}
+	}
+
+	return configured, diagnostics
+}
+
+// batchZoneRecordsChanges applies the changes to the zone as a single atomic
+// batch, and records the configured values of the updated and created records.
+func batchZoneRecordsChanges(ctx context.Context, config *common.DnsimpleProviderConfig, zoneName string, changes zoneRecordsChanges, configured map[string]zoneRecordConfiguredValue) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	request := dnsimple.BatchChangeZoneRecordsRequest{}
+
+	for _, change := range changes.deletes {
+		request.Deletes = append(request.Deletes, dnsimple.ZoneRecordDeleteRequest{ID: change.existing.ID})
+	}
+
+	for _, change := range changes.updates {
+		attributes := zoneRecordAttributes(change.desired)
+		request.Updates = append(request.Updates, dnsimple.ZoneRecordUpdateRequest{
+			ID:       change.existing.ID,
+			Type:     attributes.Type,
+			Name:     attributes.Name,
+			Content:  attributes.Content,
+			TTL:      attributes.TTL,
+			Priority: attributes.Priority,
+			Regions:  attributes.Regions,
+		})
+	}
+
+	for _, change := range changes.creates {
+		request.Creates = append(request.Creates, zoneRecordAttributes(change.desired))
+	}
+
+	if len(request.Deletes) == 0 && len(request.Updates) == 0 && len(request.Creates) == 0 {
+		return diagnostics
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Applying DNSimple Zone Records batch: %s", zoneName))
+
+	response, err := config.Client.Zones.BatchChangeZoneRecords(ctx, config.AccountID, zoneName, request)
+	if err != nil {
+		diagnostics.Append(zoneRecordsBatchErrorToDiagnostics(err, changes)...)
+		return diagnostics
+	}
+
+	for _, change := range changes.deletes {
+		config.ZoneRecordCache.Remove(config.AccountID, zoneName, change.existing.ID)
+	}
+
+	for i, record := range response.Data.Updates {
+		config.ZoneRecordCache.Upsert(config.AccountID, zoneName, record)
+		configured[zoneRecordKey(record.Name, record.Type, record.Content)] = zoneRecordConfiguredValue{
+			Name:  changes.updates[i].desired.Name,
+			Value: changes.updates[i].desired.Content,
+		}
+	}
+
+	for i, record := range response.Data.Creates {
+		config.ZoneRecordCache.Upsert(config.AccountID, zoneName, record)
+		configured[zoneRecordKey(record.Name, record.Type, record.Content)] = zoneRecordConfiguredValue{
+			Name:  changes.creates[i].desired.Name,
+			Value: changes.creates[i].desired.Content,
+		}
+	}
+
+	return diagnostics
+}
+
+// listZoneRecords returns the records of the zone that fall under the management
+// of the resource, leaving system records out when ignoreSystemRecords is set.
+func listZoneRecords(ctx context.Context, config *common.DnsimpleProviderConfig, zoneName string, ignoreSystemRecords bool) ([]dnsimple.ZoneRecord, error) {
+	records, err := common.ListAllZoneRecords(ctx, config.Client, config.AccountID, zoneName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ignoreSystemRecords {
+		return records, nil
+	}
+
+	managed := make([]dnsimple.ZoneRecord, 0, len(records))
+	for _, record := range records {
+		if record.SystemRecord {
+			continue
+		}
+		managed = append(managed, record)
+	}
+
+	return managed, nil
+}
+
+// declaredZoneRecords leaves out the system records that are not declared in
+// the configuration. The resource never deletes nor updates them, so they would
+// otherwise show up as a difference in every plan.
+func declaredZoneRecords(records []dnsimple.ZoneRecord, configured map[string]zoneRecordConfiguredValue) []dnsimple.ZoneRecord {
+	declared := make([]dnsimple.ZoneRecord, 0, len(records))
+	for _, record := range records {
+		if _, ok := configured[zoneRecordKey(record.Name, record.Type, record.Content)]; record.SystemRecord && !ok {
+			continue
+		}
+		declared = append(declared, record)
+	}
+
+	return declared
+}
+
+// deleteZoneRecords deletes every record of the zone DNSimple allows to delete.
+func deleteZoneRecords(ctx context.Context, config *common.DnsimpleProviderConfig, zoneName string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	records, err := common.ListAllZoneRecords(ctx, config.Client, config.AccountID, zoneName, nil)
+	if err != nil {
+		diagnostics.AddError(
+			"failed to delete DNSimple Zone Records",
+			fmt.Sprintf("Unable to list records of zone '%s': %s", zoneName, err.Error()),
+		)
+		return diagnostics
+	}
+
+	for _, record := range records {
+		// System records are owned by DNSimple and cannot be deleted.
+		if record.SystemRecord {
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Deleting DNSimple Record: %s, %d", zoneName, record.ID))
+
+		_, err := config.Client.Zones.DeleteRecord(ctx, config.AccountID, zoneName, record.ID)
+		if err != nil {
+			diagnostics.AddError(
+				"failed to delete DNSimple Zone Record",
+				fmt.Sprintf("Unable to delete zone record '%s' (ID: %d): %s", record.Name, record.ID, err.Error()),
+			)
+			return diagnostics
+		}
+		config.ZoneRecordCache.Remove(config.AccountID, zoneName, record.ID)
+	}
+
+	return diagnostics
+}
+
+func (r *ZoneRecordsResource) desiredRecords(ctx context.Context, data *ZoneRecordsResourceModel) ([]dnsimple.ZoneRecord, diag.Diagnostics) {
+	var (
+		diagnostics diag.Diagnostics
+		models      []ZoneRecordsRecordModel
+	)
+
+	diagnostics.Append(data.Records.ElementsAs(ctx, &models, false)...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	desired := make([]dnsimple.ZoneRecord, 0, len(models))
+	for _, model := range models {
+		record := dnsimple.ZoneRecord{
+			Name:     model.Name.ValueString(),
+			Type:     model.Type.ValueString(),
+			Content:  model.Value.ValueString(),
+			TTL:      int(model.TTL.ValueInt64()),
+			Priority: int(model.Priority.ValueInt64()),
+		}
+
+		if !model.Regions.IsNull() && !model.Regions.IsUnknown() {
+			diagnostics.Append(model.Regions.ElementsAs(ctx, &record.Regions, false)...)
+		}
+
+		desired = append(desired, record)
+	}
+
+	return desired, diagnostics
+}
+
+func (r *ZoneRecordsResource) updateModelFromAPIResponse(ctx context.Context, records []dnsimple.ZoneRecord, configured map[string]zoneRecordConfiguredValue, data *ZoneRecordsResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	models := make([]ZoneRecordsRecordModel, 0, len(records))
+	for _, record := range records {
+		model := ZoneRecordsRecordModel{
+			Name:     types.StringValue(record.Name),
+			Type:     types.StringValue(record.Type),
+			Value:    types.StringValue(record.Content),
+			TTL:      types.Int64Value(int64(record.TTL)),
+			Priority: types.Int64Value(int64(record.Priority)),
+			Regions:  types.ListNull(types.StringType),
+		}
+
+		// Keep the value as configured when the API only normalized it, to avoid a perpetual diff.
+		if value, ok := configured[zoneRecordKey(record.Name, record.Type, record.Content)]; ok {
+			model.Name = types.StringValue(value.Name)
+			model.Value = types.StringValue(value.Value)
+		}
+
+		if !isGlobalRegion(record.Regions) {
+			regions, diags := types.ListValueFrom(ctx, types.StringType, record.Regions)
+			diagnostics.Append(diags...)
+			model.Regions = regions
+		}
+
+		models = append(models, model)
+	}
+
+	recordSet, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: ZoneRecordsRecordAttrType}, models)
+	diagnostics.Append(diags...)
+	data.Records = recordSet
+
+	return diagnostics
+}
+
+func getZoneRecordsConfiguredValues(ctx context.Context, private privateStateGetter) (map[string]zoneRecordConfiguredValue, diag.Diagnostics) {
+	configured := map[string]zoneRecordConfiguredValue{}
+
+	value, diags := private.GetKey(ctx, zoneRecordsConfiguredValuesKey)
+	if diags.HasError() || len(value) == 0 {
+		return configured, diags
+	}
+
+	if err := json.Unmarshal(value, &configured); err != nil {
+		diags.AddError(
+			"failed to read DNSimple Zone Records private state",
+			err.Error(),
+		)
+	}
+
+	return configured, diags
+}
+
+func setZoneRecordsConfiguredValues(ctx context.Context, private privateStateSetter, configured map[string]zoneRecordConfiguredValue) diag.Diagnostics {
+	value, err := json.Marshal(configured)
+	if err != nil {
+		diags := diag.Diagnostics{}
+		diags.AddError(
+			"failed to write DNSimple Zone Records private state",
+			err.Error(),
+		)
+		return diags
+	}
+
+	return private.SetKey(ctx, zoneRecordsConfiguredValuesKey, value)
+}
+
+// presentZoneRecordsConfiguredValues only carries over the configured values of
+// records that are still present in the zone.
+func presentZoneRecordsConfiguredValues(records []dnsimple.ZoneRecord, configured map[string]zoneRecordConfiguredValue) map[string]zoneRecordConfiguredValue {
+	current := make(map[string]zoneRecordConfiguredValue, len(records))
+	for _, record := range records {
+		key := zoneRecordKey(record.Name, record.Type, record.Content)
+		if value, ok := configured[key]; ok {
+			current[key] = value
+		}
+	}
+
+	return current
+}
+
+// diffZoneRecords works out which records to create, update and delete for the
+// existing records of a zone to match the desired ones. A desired record matches
+// an existing one with the same name, type and content, where the content may be
+// the configured value the API normalized. Remaining records sharing name and type
+// are updated in place, so that a value change keeps the record ID. System
+// records are read-only: they are never updated nor deleted, a desired record
+// only matches one that already has the desired settings.
+func diffZoneRecords(desired, existing []dnsimple.ZoneRecord, configured map[string]zoneRecordConfiguredValue) zoneRecordsChanges {
+	changes := zoneRecordsChanges{}
+	matched := make([]bool, len(existing))
+	var pending []dnsimple.ZoneRecord
+
+	for _, want := range desired {
+		index := -1
+		for i, have := range existing {
+			if !matched[i] && zoneRecordMatches(want, have, configured) && (!have.SystemRecord || zoneRecordSettingsEqual(want, have)) {
+				index = i
+				break
+			}
+		}
+
+		if index < 0 {
+			pending = append(pending, want)
+			continue
+		}
+
+		matched[index] = true
+		change := zoneRecordChange{desired: want, existing: existing[index]}
+		if zoneRecordSettingsEqual(want, existing[index]) {
+			changes.unchanged = append(changes.unchanged, change)
+		} else {
+			changes.updates = append(changes.updates, change)
+		}
+	}
+
+	for _, want := range pending {
+		index := -1
+		for i, have := range existing {
+			if !matched[i] && !have.SystemRecord && have.Name == normalizeZoneRecordName(want.Name) && have.Type == want.Type {
+				index = i
+				break
+			}
+		}
+
+		if index < 0 {
+			changes.creates = append(changes.creates, zoneRecordChange{desired: want})
+			continue
+		}
+
+		matched[index] = true
+		changes.updates = append(changes.updates, zoneRecordChange{desired: want, existing: existing[index]})
+	}
+
+	for i, have := range existing {
+		if !matched[i] && !have.SystemRecord {
+			changes.deletes = append(changes.deletes, zoneRecordChange{existing: have})
+		}
+	}
+
+	return changes
+}
+
+func zoneRecordMatches(want, have dnsimple.ZoneRecord, configured map[string]zoneRecordConfiguredValue) bool {
+	if have.Name != normalizeZoneRecordName(want.Name) || have.Type != want.Type {
+		return false
+	}
+
+	if modifiers.RecordValuesEqual(want.Type, want.Content, have.Content) {
+		return true
+	}
+
+	value, ok := configured[zoneRecordKey(have.Name, have.Type, have.Content)]
+	return ok && value.Value == want.Content
+}
+
+func zoneRecordSettingsEqual(want, have dnsimple.ZoneRecord) bool {
+	if want.TTL != have.TTL || want.Priority != have.Priority {
+		return false
+	}
+
+	if isGlobalRegion(want.Regions) || isGlobalRegion(have.Regions) {
+		return isGlobalRegion(want.Regions) && isGlobalRegion(have.Regions)
+	}
+
+	wantRegions := slices.Clone(want.Regions)
+	haveRegions := slices.Clone(have.Regions)
+	slices.Sort(wantRegions)
+	slices.Sort(haveRegions)
+
+	return slices.Equal(wantRegions, haveRegions)
+}
+
+// isGlobalRegion reports whether regions means the record is served from every region.
+func isGlobalRegion(regions []string) bool {
+	return len(regions) == 0 || (len(regions) == 1 && regions[0] == "global")
+}
+
+// normalizeZoneRecordName returns the name the API stores for a configured record name.
+func normalizeZoneRecordName(name string) string {
+	if name == "@" {
+		return ""
+	}
+	return name
+}
+
+func zoneRecordKey(name, recordType, content string) string {
+	return fmt.Sprintf("%s/%s/%s", name, recordType, content)
+}
+
+func zoneRecordAttributes(record dnsimple.ZoneRecord) dnsimple.ZoneRecordAttributes {
+	regions := record.Regions
+	if len(regions) == 0 {
+		// Empty regions are omitted from the request, which would leave the regions of an updated record as they were.
+		regions = []string{"global"}
+	}
+
+	return dnsimple.ZoneRecordAttributes{
+		Name:     dnsimple.String(record.Name),
+		Type:     record.Type,
+		Content:  record.Content,
+		TTL:      record.TTL,
+		Priority: record.Priority,
+		Regions:  regions,
+	}
+}
+
+// zoneRecordsBatchErrorToDiagnostics reports the errors of a rejected batch
+// against the records whose change failed.
+func zoneRecordsBatchErrorToDiagnostics(err error, changes zoneRecordsChanges) diag.Diagnostics {
+	var errorResponse *dnsimple.ErrorResponse
+	if !errors.As(err, &errorResponse) || len(errorResponse.AttributeErrors) == 0 {
+		return zoneRecordsAPIErrorToDiagnostics(err, "failed to apply DNSimple Zone Records changes")
+	}
+
+	diagnostics := diag.Diagnostics{}
+	operations := []struct {
+		kind    string
+		summary string
+		records []zoneRecordChange
+		desired bool
+	}{
+		{common.BatchDeletes, "failed to delete DNSimple Zone Record", changes.deletes, false},
+		{common.BatchUpdates, "failed to update DNSimple Zone Record", changes.updates, true},
+		{common.BatchCreates, "failed to create DNSimple Zone Record", changes.creates, true},
+	}
+
+	for _, operation := range operations {
+		for i, change := range operation.records {
+			// Changes without errors of their own were only rejected along with the others.
+			if !zoneRecordsBatchOperationFailed(errorResponse, operation.kind, i) {
+				continue
+			}
+
+			var operationError *dnsimple.ErrorResponse
+			if !errors.As(common.BatchOperationError(err, operation.kind, i), &operationError) {
+				continue
+			}
+
+			record := change.existing
+			if operation.desired {
+				record = change.desired
+			}
+
+			details := []string{operationError.Message}
+			for field, messages := range operationError.AttributeErrors {
+				details = append(details, fmt.Sprintf("%s %s", utils.TranslateFieldFromAPIToTerraform(field), strings.Join(messages, ", ")))
+			}
+
+			diagnostics.AddError(
+				operation.summary,
+				fmt.Sprintf("%s record '%s' with value '%s': %s", record.Type, record.Name, record.Content, strings.Join(details, "; ")),
+			)
+		}
+	}
+
+	if !diagnostics.HasError() {
+		return utils.AttributeErrorsToDiagnostics(errorResponse)
+	}
+
+	return diagnostics
+}
+
+// zoneRecordsBatchOperationFailed reports whether the API rejected the
+// operation of the batch at the given index.
+func zoneRecordsBatchOperationFailed(errorResponse *dnsimple.ErrorResponse, kind string, index int) bool {
+	prefix := fmt.Sprintf("%s[%d]", kind, index)
+	for key := range errorResponse.AttributeErrors {
+		if key == prefix || strings.HasPrefix(key, prefix+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func zoneRecordsAPIErrorToDiagnostics(err error, summary string) diag.Diagnostics {
+	var errorResponse *dnsimple.ErrorResponse
+	if errors.As(err, &errorResponse) {
+		return utils.AttributeErrorsToDiagnostics(errorResponse)
+	}
+
+	diagnostics := diag.Diagnostics{}
+	diagnostics.AddError(summary, err.Error())
+	return diagnostics
+}
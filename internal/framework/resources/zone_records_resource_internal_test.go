@@ -0,0 +1,184 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffZoneRecords(t *testing.T) {
+	existing := []dnsimple.ZoneRecord{
+		{ID: 1, Name: "", Type: "A", Content: "192.0.2.1", TTL: 3600},
+		{ID: 2, Name: "www", Type: "CNAME", Content: "example.com", TTL: 3600},
+		{ID: 3, Name: "", Type: "TXT", Content: "\"v=spf1 -all\"", TTL: 3600},
+		{ID: 4, Name: "old", Type: "A", Content: "192.0.2.9", TTL: 3600},
+		{ID: 5, Name: "", Type: "MX", Content: "mx1.example.com", TTL: 3600, Priority: 10},
+	}
+	configured := map[string]zoneRecordConfiguredValue{
+		zoneRecordKey("", "TXT", "\"v=spf1 -all\""): {Name: "@", Value: "v=spf1 -all"},
+	}
+	desired := []dnsimple.ZoneRecord{
+		// unchanged, matched on the apex name given as "@"
+		{Name: "@", Type: "A", Content: "192.0.2.1", TTL: 3600},
+		// TTL change
+		{Name: "www", Type: "CNAME", Content: "example.com", TTL: 300},
+		// unchanged, matched through the value the API normalized
+		{Name: "@", Type: "TXT", Content: "v=spf1 -all", TTL: 3600},
+		// content change of the single MX record, updated in place
+		{Name: "", Type: "MX", Content: "mx2.example.com", TTL: 3600, Priority: 10},
+		// new record
+		{Name: "new", Type: "A", Content: "192.0.2.2", TTL: 3600},
+	}
+
+	changes := diffZoneRecords(desired, existing, configured)
+
+	assert.Len(t, changes.unchanged, 2)
+	assert.Equal(t, int64(1), changes.unchanged[0].existing.ID)
+	assert.Equal(t, int64(3), changes.unchanged[1].existing.ID)
+
+	assert.Len(t, changes.updates, 2)
+	assert.Equal(t, int64(2), changes.updates[0].existing.ID)
+	assert.Equal(t, 300, changes.updates[0].desired.TTL)
+	assert.Equal(t, int64(5), changes.updates[1].existing.ID)
+	assert.Equal(t, "mx2.example.com", changes.updates[1].desired.Content)
+
+	assert.Len(t, changes.creates, 1)
+	assert.Equal(t, "new", changes.creates[0].desired.Name)
+
+	assert.Len(t, changes.deletes, 1)
+	assert.Equal(t, int64(4), changes.deletes[0].existing.ID)
+}
+
+func TestDiffZoneRecords_SharedNameAndType(t *testing.T) {
+	existing := []dnsimple.ZoneRecord{
+		{ID: 1, Name: "www", Type: "A", Content: "192.0.2.1", TTL: 3600},
+		{ID: 2, Name: "www", Type: "A", Content: "192.0.2.2", TTL: 3600},
+		{ID: 3, Name: "www", Type: "A", Content: "192.0.2.3", TTL: 3600},
+	}
+	desired := []dnsimple.ZoneRecord{
+		{Name: "www", Type: "A", Content: "192.0.2.2", TTL: 3600},
+		{Name: "www", Type: "A", Content: "192.0.2.4", TTL: 3600},
+	}
+
+	changes := diffZoneRecords(desired, existing, nil)
+
+	assert.Len(t, changes.unchanged, 1)
+	assert.Equal(t, int64(2), changes.unchanged[0].existing.ID)
+	assert.Len(t, changes.updates, 1)
+	assert.Equal(t, int64(1), changes.updates[0].existing.ID)
+	assert.Equal(t, "192.0.2.4", changes.updates[0].desired.Content)
+	assert.Empty(t, changes.creates)
+	assert.Len(t, changes.deletes, 1)
+	assert.Equal(t, int64(3), changes.deletes[0].existing.ID)
+}
+
+func TestDiffZoneRecords_SystemRecords(t *testing.T) {
+	existing := []dnsimple.ZoneRecord{
+		{ID: 1, Name: "", Type: "SOA", Content: "ns1.dnsimple.com admin.dnsimple.com 1 86400 7200 604800 300", TTL: 3600, SystemRecord: true},
+		{ID: 2, Name: "", Type: "NS", Content: "ns1.dnsimple.com", TTL: 3600, SystemRecord: true},
+		{ID: 3, Name: "", Type: "NS", Content: "ns2.dnsimple.com", TTL: 3600, SystemRecord: true},
+	}
+	desired := []dnsimple.ZoneRecord{
+		// unchanged system record
+		{Name: "", Type: "NS", Content: "ns1.dnsimple.com", TTL: 3600},
+		// same name and type as a system record, created instead of updating it
+		{Name: "", Type: "NS", Content: "ns.example.net", TTL: 3600},
+	}
+
+	changes := diffZoneRecords(desired, existing, nil)
+
+	assert.Len(t, changes.unchanged, 1)
+	assert.Equal(t, int64(2), changes.unchanged[0].existing.ID)
+	assert.Empty(t, changes.updates)
+	assert.Len(t, changes.creates, 1)
+	assert.Equal(t, "ns.example.net", changes.creates[0].desired.Content)
+	assert.Empty(t, changes.deletes)
+}
+
+func TestDeclaredZoneRecords(t *testing.T) {
+	records := []dnsimple.ZoneRecord{
+		{ID: 1, Name: "", Type: "SOA", Content: "ns1.dnsimple.com admin.dnsimple.com 1 86400 7200 604800 300", SystemRecord: true},
+		{ID: 2, Name: "", Type: "NS", Content: "ns1.dnsimple.com", SystemRecord: true},
+		{ID: 3, Name: "www", Type: "A", Content: "192.0.2.1"},
+	}
+	configured := map[string]zoneRecordConfiguredValue{
+		zoneRecordKey("", "NS", "ns1.dnsimple.com"): {Name: "@", Value: "ns1.dnsimple.com"},
+	}
+
+	declared := declaredZoneRecords(records, configured)
+
+	ids := make([]int64, 0, len(declared))
+	for _, record := range declared {
+		ids = append(ids, record.ID)
+	}
+	assert.Equal(t, []int64{2, 3}, ids)
+}
+
+func TestZoneRecordSettingsEqual_Regions(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		want []string
+		have []string
+		same bool
+	}{
+		{name: "unset matches global", want: nil, have: []string{"global"}, same: true},
+		{name: "order does not matter", want: []string{"SYD", "IAD"}, have: []string{"IAD", "SYD"}, same: true},
+		{name: "unset does not match a region", want: nil, have: []string{"IAD"}, same: false},
+		{name: "different regions", want: []string{"IAD"}, have: []string{"SYD"}, same: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			want := dnsimple.ZoneRecord{TTL: 3600, Regions: tt.want}
+			have := dnsimple.ZoneRecord{TTL: 3600, Regions: tt.have}
+
+			assert.Equal(t, tt.same, zoneRecordSettingsEqual(want, have))
+		})
+	}
+}
+
+func TestDiffZoneRecords_EquivalentValues(t *testing.T) {
+	existing := []dnsimple.ZoneRecord{
+		{ID: 1, Name: "www", Type: "CNAME", Content: "example.com", TTL: 3600},
+		{ID: 2, Name: "", Type: "AAAA", Content: "2001:db8::1", TTL: 3600},
+		{ID: 3, Name: "", Type: "TXT", Content: "\"v=spf1 -all\"", TTL: 3600},
+	}
+	desired := []dnsimple.ZoneRecord{
+		{Name: "www", Type: "CNAME", Content: "example.com.", TTL: 3600},
+		{Name: "", Type: "AAAA", Content: "2001:0db8:0:0::1", TTL: 3600},
+		{Name: "", Type: "TXT", Content: "v=spf1 -all", TTL: 3600},
+	}
+
+	changes := diffZoneRecords(desired, existing, nil)
+
+	assert.Len(t, changes.unchanged, 3)
+	assert.Empty(t, changes.creates)
+	assert.Empty(t, changes.updates)
+	assert.Empty(t, changes.deletes)
+}
+
+func TestZoneRecordsBatchErrorToDiagnostics(t *testing.T) {
+	changes := zoneRecordsChanges{
+		deletes: []zoneRecordChange{
+			{existing: dnsimple.ZoneRecord{ID: 4, Name: "old", Type: "A", Content: "192.0.2.9"}},
+		},
+		creates: []zoneRecordChange{
+			{desired: dnsimple.ZoneRecord{Name: "www", Type: "A", Content: "192.0.2.1"}},
+			{desired: dnsimple.ZoneRecord{Name: "api", Type: "A", Content: "192.0.2.300"}},
+		},
+	}
+	err := &dnsimple.ErrorResponse{
+		Message: "Validation failed",
+		AttributeErrors: map[string][]string{
+			"creates[1]":         {"Validation failed"},
+			"creates[1].content": {"is not a valid IPv4 address"},
+		},
+	}
+
+	diagnostics := zoneRecordsBatchErrorToDiagnostics(err, changes)
+
+	// Only the rejected change is reported.
+	if assert.Len(t, diagnostics, 1) {
+		assert.Equal(t, "failed to create DNSimple Zone Record", diagnostics[0].Summary())
+		assert.Equal(t, "A record 'api' with value '192.0.2.300': Validation failed; value is not a valid IPv4 address", diagnostics[0].Detail())
+	}
+}
@@ -0,0 +1,177 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestAccZoneRecordsResource(t *testing.T) {
+	// The resource owns every record of the zone, so use a dedicated zone
+	// rather than the shared acceptance test domain.
+	zoneName := "zone-records-" + os.Getenv("DNSIMPLE_DOMAIN")
+	resourceName := "dnsimple_zone_records.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDomainResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsResourceConfig(zoneName, "192.0.2.1", 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneName),
+					resource.TestCheckResourceAttr(resourceName, "zone_name", zoneName),
+					resource.TestCheckResourceAttr(resourceName, "ignore_system_records", "true"),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name":  "www",
+						"type":  "A",
+						"value": "192.0.2.1",
+						"ttl":   "3600",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name":     "",
+						"type":     "MX",
+						"value":    "mx.example.com",
+						"priority": "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name":  "@",
+						"type":  "TXT",
+						"value": "v=spf1 -all",
+					}),
+				),
+			},
+			{
+				Config: testAccZoneRecordsResourceConfig(zoneName, "192.0.2.2", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name":  "www",
+						"type":  "A",
+						"value": "192.0.2.2",
+						"ttl":   "600",
+					}),
+				),
+			},
+			{
+				// A record added outside of Terraform shows up as drift.
+				PreConfig: func() {
+					testAccCreateZoneRecord(t, zoneName, "drift", "A", "192.0.2.3")
+				},
+				Config:             testAccZoneRecordsResourceConfig(zoneName, "192.0.2.2", 600),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Applying the configuration again removes it.
+				Config: testAccZoneRecordsResourceConfig(zoneName, "192.0.2.2", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					testAccCheckZoneRecordsCount(zoneName, 3),
+				),
+			},
+			{
+				// The apex name and TXT value are imported as the API stores them ("" and
+				// quoted), rather than as configured.
+				ResourceName:            resourceName,
+				ImportStateId:           zoneName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"records"},
+			},
+			{
+				// System records that are not declared are left out of the
+				// record set, so that the plan converges.
+				Config: testAccZoneRecordsResourceConfigWithoutIgnoringSystemRecords(zoneName, "192.0.2.2", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ignore_system_records", "false"),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					testAccCheckZoneRecordsCount(zoneName, 3),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCreateZoneRecord(t *testing.T, zoneName, name, recordType, content string) {
+	_, err := dnsimpleClient.Zones.CreateRecord(context.Background(), testAccAccount, zoneName, dnsimple.ZoneRecordAttributes{
+		Name:    dnsimple.String(name),
+		Type:    recordType,
+		Content: content,
+	})
+	if err != nil {
+		t.Fatalf("error creating zone record outside of Terraform: %s", err)
+	}
+}
+
+func testAccCheckZoneRecordsCount(zoneName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		count := 0
+		response, err := dnsimpleClient.Zones.ListRecords(context.Background(), testAccAccount, zoneName, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range response.Data {
+			if !record.SystemRecord {
+				count++
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("expected %d records in zone %s, got %d", expected, zoneName, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccZoneRecordsResourceConfig(zoneName string, address string, ttl int) string {
+	return testAccZoneRecordsResourceConfigIgnoringSystemRecords(zoneName, address, ttl, true)
+}
+
+func testAccZoneRecordsResourceConfigWithoutIgnoringSystemRecords(zoneName string, address string, ttl int) string {
+	return testAccZoneRecordsResourceConfigIgnoringSystemRecords(zoneName, address, ttl, false)
+}
+
+func testAccZoneRecordsResourceConfigIgnoringSystemRecords(zoneName string, address string, ttl int, ignoreSystemRecords bool) string {
+	return fmt.Sprintf(`
+resource "dnsimple_domain" "test" {
+	name = %[1]q
+}
+
+resource "dnsimple_zone_records" "test" {
+	zone_name             = dnsimple_domain.test.name
+	ignore_system_records = %[4]t
+
+	records = [
+		{
+			name  = "www"
+			type  = "A"
+			value = %[2]q
+			ttl   = %[3]d
+		},
+		{
+			name     = ""
+			type     = "MX"
+			value    = "mx.example.com"
+			priority = 10
+		},
+		{
+			name  = "@"
+			type  = "TXT"
+			value = "v=spf1 -all"
+		},
+	]
+}`, zoneName, address, ttl, ignoreSystemRecords)
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -36,20 +41,24 @@ type ZoneResource struct {
 
 // ZoneResourceModel describes the resource data model.
 type ZoneResourceModel struct {
-	Name              types.String `tfsdk:"name"`
-	AccountId         types.Int64  `tfsdk:"account_id"`
-	Reverse           types.Bool   `tfsdk:"reverse"`
-	Secondary         types.Bool   `tfsdk:"secondary"`
-	Active            types.Bool   `tfsdk:"active"`
-	LastTransferredAt types.String `tfsdk:"last_transferred_at"`
-	Id                types.Int64  `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	AdoptExisting       types.Bool     `tfsdk:"adopt_existing"`
+	PreventDelete       types.Bool     `tfsdk:"prevent_delete"`
+	AccountId           types.Int64    `tfsdk:"account_id"`
+	Reverse             types.Bool     `tfsdk:"reverse"`
+	Secondary           types.Bool     `tfsdk:"secondary"`
+	Active              types.Bool     `tfsdk:"active"`
+	LastTransferredAt   types.String   `tfsdk:"last_transferred_at"`
+	WaitForDistribution types.Bool     `tfsdk:"wait_for_distribution"`
+	Id                  types.Int64    `tfsdk:"id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_zone"
 }
 
-func (r *ZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ZoneResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "DNSimple zone resource",
@@ -60,6 +69,26 @@ func (r *ZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			/*
+			 * Zones managed before the zone could be created were all adopted,
+			 * and so are imported zones. Without a configured value, the value
+			 * in state is kept so that these zones are never deleted on destroy,
+			 * while new zones default to being created and deleted.
+			 */
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to manage a zone that already exists in DNSimple instead of creating it. An adopted zone is only removed from the Terraform state on destroy. Defaults to `false` for new zones, in which case creating the resource fails if the zone already exists.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prevent_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether to block `terraform destroy` from deleting the zone. Defaults to `false`. When set to `true`, destroying this resource fails until it is set back to `false` and applied.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"account_id": schema.Int64Attribute{
 				Computed: true,
 			},
@@ -76,7 +105,14 @@ func (r *ZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"last_transferred_at": schema.StringAttribute{
 				Computed: true,
 			},
-			"id": common.IDInt64Attribute(),
+			"wait_for_distribution": waitForDistributionAttribute("Whether to wait, when the zone is created or updated, until the zone is distributed to all the DNSimple name servers. The wait is bounded by the `create` and `update` timeouts, 5 minutes by default."),
+			"id":                    common.IDInt64Attribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 		},
 	}
 }
@@ -111,41 +147,105 @@ func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	response, err := r.config.Client.Zones.GetZone(ctx, r.config.AccountID, data.Name.ValueString())
-	if err != nil {
-		var errorResponse *dnsimple.ErrorResponse
-		if errors.As(err, &errorResponse) {
-			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
-			return
-		}
+	// adopt_existing is unknown when it is not configured.
+	data.AdoptExisting = types.BoolValue(data.AdoptExisting.ValueBool())
 
-		resp.Diagnostics.AddError(
-			"failed to read DNSimple Zone",
-			err.Error(),
-		)
+	zone, diags := r.createOrAdoptZone(ctx, data)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	if !(data.Active.IsUnknown() || data.Active.IsNull()) && data.Active.ValueBool() != response.Data.Active {
-		zone, diags := r.setActiveState(ctx, data)
+	if !(data.Active.IsUnknown() || data.Active.IsNull()) && data.Active.ValueBool() != zone.Active {
+		var diags diag.Diagnostics
+		zone, diags = r.setActiveState(ctx, data)
 
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
 		}
+	}
 
-		r.updateModelFromAPIResponse(zone, data)
+	r.updateModelFromAPIResponse(zone, data)
 
-		// Save data into Terraform state
-		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() || !data.WaitForDistribution.ValueBool() {
 		return
 	}
 
-	r.updateModelFromAPIResponse(response.Data, data)
+	timeout, diags := data.Timeouts.Create(ctx, defaultDistributionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.waitForDistribution(ctx, data, timeout)...)
+}
+
+// createOrAdoptZone returns the zone to manage. An existing zone is only
+// adopted when adopt_existing is set, otherwise the zone is created through
+// the hosted domain backing it.
+func (r *ZoneResource) createOrAdoptZone(ctx context.Context, data *ZoneResourceModel) (*dnsimple.Zone, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+
+	response, err := r.config.Client.Zones.GetZone(ctx, r.config.AccountID, data.Name.ValueString())
+	if err == nil {
+		if !data.AdoptExisting.ValueBool() {
+			diagnostics.AddAttributeError(
+				path.Root("name"),
+				"DNSimple Zone already exists",
+				fmt.Sprintf("Zone '%s' already exists. Set adopt_existing to true to manage it without deleting it on destroy, or import it.", data.Name.ValueString()),
+			)
+			return nil, diagnostics
+		}
+
+		return response.Data, diagnostics
+	}
+
+	var errorResponse *dnsimple.ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		diagnostics.AddError(
+			"failed to read DNSimple Zone",
+			err.Error(),
+		)
+		return nil, diagnostics
+	}
+
+	if data.AdoptExisting.ValueBool() || errorResponse.HTTPResponse == nil || errorResponse.HTTPResponse.StatusCode != http.StatusNotFound {
+		diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+		return nil, diagnostics
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating DNSimple Zone: %s", data.Name.ValueString()))
+
+	domainResponse, err := r.config.Client.Domains.CreateDomain(ctx, r.config.AccountID, dnsimple.Domain{Name: data.Name.ValueString()})
+	if err != nil {
+		if errors.As(err, &errorResponse) {
+			diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return nil, diagnostics
+		}
+
+		diagnostics.AddError(
+			"failed to create DNSimple Zone",
+			err.Error(),
+		)
+		return nil, diagnostics
+	}
+
+	r.config.DomainCache.Upsert(r.config.AccountID, *domainResponse.Data)
+
+	response, err = r.config.Client.Zones.GetZone(ctx, r.config.AccountID, domainResponse.Data.Name)
+	if err != nil {
+		diagnostics.AddError(
+			"failed to read DNSimple Zone",
+			fmt.Sprintf("Unable to read zone '%s' after creating it: %s", domainResponse.Data.Name, err.Error()),
+		)
+		return nil, diagnostics
+	}
+
+	return response.Data, diagnostics
 }
 
 func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -160,6 +260,13 @@ func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	response, err := r.config.Client.Zones.GetZone(ctx, r.config.AccountID, data.Name.ValueString())
 	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.HTTPResponse != nil && errorResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			tflog.Warn(ctx, "removing zone from state because it is not present in the remote")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"failed to read DNSimple Zone",
 			fmt.Sprintf("Unable to read zone '%s': %s", data.Name.ValueString(), err.Error()),
@@ -169,6 +276,12 @@ func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	r.updateModelFromAPIResponse(response.Data, data)
 
+	// Zones imported, or managed before wait_for_distribution existed, have
+	// no value for it in state.
+	if data.WaitForDistribution.IsNull() {
+		data.WaitForDistribution = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -195,21 +308,52 @@ func (r *ZoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	if stateData.AdoptExisting.ValueBool() && !planData.AdoptExisting.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("adopt_existing"),
+			"the zone is no longer adopted and is deleted on destroy.",
+			fmt.Sprintf("Destroying this resource will now DELETE the zone %s and all its records. Set prevent_delete to true to guard against it.", planData.Name.ValueString()),
+		)
+	}
+
+	var zone *dnsimple.Zone
 	if !(planData.Active.IsUnknown() || planData.Active.IsNull()) && planData.Active.ValueBool() != stateData.Active.ValueBool() {
-		zone, diags := r.setActiveState(ctx, planData)
+		var diags diag.Diagnostics
+		zone, diags = r.setActiveState(ctx, planData)
 
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
 		}
+	} else {
+		response, err := r.config.Client.Zones.GetZone(ctx, r.config.AccountID, planData.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to read DNSimple Zone",
+				fmt.Sprintf("Unable to read zone '%s': %s", planData.Name.ValueString(), err.Error()),
+			)
+			return
+		}
 
-		r.updateModelFromAPIResponse(zone, planData)
+		zone = response.Data
+	}
 
-		// Save data into Terraform state
-		resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	r.updateModelFromAPIResponse(zone, planData)
 
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() || !planData.WaitForDistribution.ValueBool() {
 		return
 	}
+
+	timeout, diags := planData.Timeouts.Update(ctx, defaultDistributionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForDistribution(ctx, planData, timeout)...)
 }
 
 func (r *ZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -220,7 +364,42 @@ func (r *ZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	tflog.Warn(ctx, fmt.Sprintf("Removing DNSimple Zone from Terraform state only: %s, %s", data.Name, data.Id))
+	// State written before adopt_existing existed has no value for it, and
+	// the zone was adopted.
+	if data.AdoptExisting.IsNull() || data.AdoptExisting.ValueBool() {
+		tflog.Warn(ctx, fmt.Sprintf("Removing adopted DNSimple Zone from Terraform state only: %s, %s", data.Name, data.Id))
+		return
+	}
+
+	if data.PreventDelete.ValueBool() {
+		resp.Diagnostics.AddError(
+			"failed to delete DNSimple Zone",
+			"Zone deletion protection enabled.",
+		)
+		resp.Diagnostics.AddWarning(
+			"the records of the zone are lost when deleting zone resources.",
+			fmt.Sprintf("Disabling deletion protection and destroying this resource will DELETE the zone %s and all its records. Note this also blocks the destroy half of a replacement, so changing 'name' fails until protection is disabled.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting DNSimple Zone: %s, %s", data.Name, data.Id))
+
+	// The zone is deleted along with the hosted domain backing it.
+	_, err := r.config.Client.Domains.DeleteDomain(ctx, r.config.AccountID, data.Name.ValueString())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if !errors.As(err, &errorResponse) || errorResponse.HTTPResponse == nil || errorResponse.HTTPResponse.StatusCode != http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"failed to delete DNSimple Zone",
+				fmt.Sprintf("Unable to delete zone '%s': %s", data.Name.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	r.config.DomainCache.Remove(r.config.AccountID, data.Name.ValueString())
+	r.config.ZoneRecordCache.Invalidate(r.config.AccountID, data.Name.ValueString())
 }
 
 func (r *ZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -238,6 +417,16 @@ func (r *ZoneResource) ImportState(ctx context.Context, req resource.ImportState
 }
 
 func (r *ZoneResource) updateModelFromAPIResponse(zone *dnsimple.Zone, data *ZoneResourceModel) {
+	// adopt_existing and prevent_delete are stored only in state. Zones
+	// managed before they existed, and imported zones, were adopted and are
+	// not protected.
+	if data.AdoptExisting.IsNull() || data.AdoptExisting.IsUnknown() {
+		data.AdoptExisting = types.BoolValue(true)
+	}
+	if data.PreventDelete.IsNull() || data.PreventDelete.IsUnknown() {
+		data.PreventDelete = types.BoolValue(false)
+	}
+
 	data.Id = types.Int64Value(zone.ID)
 	data.Name = types.StringValue(zone.Name)
 	data.AccountId = types.Int64Value(zone.AccountID)
@@ -247,6 +436,22 @@ func (r *ZoneResource) updateModelFromAPIResponse(zone *dnsimple.Zone, data *Zon
 	data.LastTransferredAt = types.StringValue(zone.LastTransferredAt)
 }
 
+// waitForDistribution waits until the zone is distributed to all the DNSimple
+// name servers.
+func (r *ZoneResource) waitForDistribution(ctx context.Context, data *ZoneResourceModel, timeout time.Duration) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	err := waitForZoneDistribution(ctx, r.config, data.Name.ValueString(), timeout)
+	if err != nil {
+		diagnostics.AddError(
+			"failed to wait for DNSimple Zone distribution",
+			fmt.Sprintf("Zone '%s' (ID: %d) is not distributed yet: %s", data.Name.ValueString(), data.Id.ValueInt64(), err.Error()),
+		)
+	}
+
+	return diagnostics
+}
+
 func (r *ZoneResource) setActiveState(ctx context.Context, data *ZoneResourceModel) (*dnsimple.Zone, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
 
@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+// newZoneTestResource returns a zone resource backed by a server holding the
+// given zones, where creating a domain creates its zone.
+func newZoneTestResource(t *testing.T, zones ...string) *ZoneResource {
+	t.Helper()
+
+	var mu sync.Mutex
+	existing := map[string]bool{}
+	for _, zone := range zones {
+		existing[zone] = true
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/1010/domains":
+			var domain dnsimple.Domain
+			_ = json.NewDecoder(r.Body).Decode(&domain)
+			existing[domain.Name] = true
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": dnsimple.Domain{ID: 1, Name: domain.Name}})
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v2/1010/zones/"):
+			name := strings.TrimPrefix(r.URL.Path, "/v2/1010/zones/")
+			if !existing[name] {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message":"Zone not found"}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": dnsimple.Zone{ID: 1, Name: name, Active: true}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+
+	return &ZoneResource{config: &common.DnsimpleProviderConfig{
+		Client:          client,
+		AccountID:       "1010",
+		DomainCache:     common.NewDomainCache(0),
+		ZoneRecordCache: common.NewZoneRecordCache(0),
+	}}
+}
+
+func TestZoneResource_createOrAdoptZone(t *testing.T) {
+	r := newZoneTestResource(t, "existing.com")
+
+	for _, tt := range []struct {
+		name    string
+		zone    string
+		adopt   bool
+		wantErr string
+	}{
+		{name: "missing zone is created", zone: "new.com", adopt: false},
+		{name: "existing zone is adopted", zone: "existing.com", adopt: true},
+		{name: "existing zone is not taken over without adopting it", zone: "existing.com", adopt: false, wantErr: "DNSimple Zone already exists"},
+		{name: "missing zone cannot be adopted", zone: "missing.com", adopt: true, wantErr: "DNSimple API returned an error"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ZoneResourceModel{Name: types.StringValue(tt.zone), AdoptExisting: types.BoolValue(tt.adopt)}
+
+			zone, diags := r.createOrAdoptZone(context.Background(), data)
+
+			if tt.wantErr != "" {
+				if assert.True(t, diags.HasError()) {
+					assert.Equal(t, tt.wantErr, diags.Errors()[0].Summary())
+				}
+				return
+			}
+
+			if assert.False(t, diags.HasError(), diags) {
+				assert.Equal(t, tt.zone, zone.Name)
+			}
+		})
+	}
+}
+
+// adopt_existing and prevent_delete are only stored in state. Zones managed
+// by a provider version that predates them were adopted, so refresh settles a
+// missing value to an adopted, unprotected zone, which is never deleted on
+// destroy. Explicit values must survive.
+func TestZoneResource_updateModelFromAPIResponse_Adoption(t *testing.T) {
+	zone := &dnsimple.Zone{ID: 1, Name: "example.com"}
+
+	for _, tt := range []struct {
+		name                   string
+		adopt, protect         types.Bool
+		wantAdopt, wantProtect bool
+	}{
+		{name: "null prior state settles to adopted and unprotected", adopt: types.BoolNull(), protect: types.BoolNull(), wantAdopt: true, wantProtect: false},
+		{name: "created zone stays created", adopt: types.BoolValue(false), protect: types.BoolValue(false), wantAdopt: false, wantProtect: false},
+		{name: "explicit opt-in to protection is preserved", adopt: types.BoolValue(false), protect: types.BoolValue(true), wantAdopt: false, wantProtect: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ZoneResource{}
+			data := &ZoneResourceModel{AdoptExisting: tt.adopt, PreventDelete: tt.protect}
+
+			r.updateModelFromAPIResponse(zone, data)
+
+			assert.Equal(t, tt.wantAdopt, data.AdoptExisting.ValueBool())
+			assert.Equal(t, tt.wantProtect, data.PreventDelete.ValueBool())
+		})
+	}
+}
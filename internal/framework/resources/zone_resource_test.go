@@ -1,15 +1,18 @@
 package resources_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	_ "github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/resources"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
 )
 
 func TestAccZoneResource(t *testing.T) {
@@ -27,6 +30,7 @@ func TestAccZoneResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "reverse", "false"),
 					resource.TestCheckResourceAttr(resourceName, "secondary", "false"),
 					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
 				),
 			},
 			{
@@ -47,6 +51,19 @@ func TestAccZoneResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "active", "true"),
 				),
 			},
+			{
+				Config: testAccZoneResourceConfigWithWaitForDistribution(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", zoneName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_distribution", "true"),
+				),
+			},
+			{
+				Config: testAccZoneResourceConfigWithActive(zoneName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_distribution", "false"),
+				),
+			},
 			{
 				ResourceName:      resourceName,
 				ImportStateIdFunc: testAccZoneImportStateIDFunc(resourceName),
@@ -58,6 +75,64 @@ func TestAccZoneResource(t *testing.T) {
 	})
 }
 
+func TestAccZoneResource_CreateAndDelete(t *testing.T) {
+	zoneName := utils.RandomName("com", "zone")
+	resourceName := "dnsimple_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test_utils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The zone is created since it does not exist yet.
+				Config: testAccZoneResourceCreateConfig(zoneName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", zoneName),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "false"),
+					resource.TestCheckResourceAttr(resourceName, "prevent_delete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccZoneResourceCreateConfig(zoneName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prevent_delete", "true"),
+				),
+			},
+			{
+				Config:      testAccZoneResourceCreateConfig(zoneName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Zone deletion protection enabled"),
+			},
+			{
+				// Opting back out again, so that the destroy that ends the test
+				// case deletes the zone.
+				Config: testAccZoneResourceCreateConfig(zoneName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prevent_delete", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckZoneResourceDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "dnsimple_zone" {
+			continue
+		}
+
+		_, err := dnsimpleClient.Zones.GetZone(context.Background(), testAccAccount, rs.Primary.Attributes["name"])
+		if err == nil {
+			return fmt.Errorf("zone still exists")
+		}
+	}
+	return nil
+}
+
 func testAccZoneImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -77,6 +152,7 @@ func testAccZoneResourceConfig(zoneName string) string {
 	return fmt.Sprintf(`
 resource "dnsimple_zone" "test" {
 	name = %[1]q
+	adopt_existing = true
 }`, zoneName)
 }
 
@@ -84,6 +160,29 @@ func testAccZoneResourceConfigWithActive(zoneName string, active bool) string {
 	return fmt.Sprintf(`
 resource "dnsimple_zone" "test" {
 	name = %[1]q
+	adopt_existing = true
 	active = %[2]t
 }`, zoneName, active)
 }
+
+func testAccZoneResourceConfigWithWaitForDistribution(zoneName string) string {
+	return fmt.Sprintf(`
+resource "dnsimple_zone" "test" {
+	name = %[1]q
+	adopt_existing = true
+	active = true
+	wait_for_distribution = true
+
+	timeouts {
+		update = "2m"
+	}
+}`, zoneName)
+}
+
+func testAccZoneResourceCreateConfig(zoneName string, preventDelete bool) string {
+	return fmt.Sprintf(`
+resource "dnsimple_zone" "test" {
+	name           = %[1]q
+	prevent_delete = %[2]t
+}`, zoneName, preventDelete)
+}
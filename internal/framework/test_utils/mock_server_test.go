@@ -0,0 +1,156 @@
+package test_utils_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/test_utils"
+)
+
+func TestMockServer_ZoneRecords(t *testing.T) {
+	ctx := context.Background()
+	server, client := test_utils.NewMockClient(t)
+	server.AddDomain("example.com")
+	account := test_utils.MockServerAccount
+
+	name := "www"
+	created, err := client.Zones.CreateRecord(ctx, account, "example.com", dnsimple.ZoneRecordAttributes{
+		Name:    &name,
+		Type:    "A",
+		Content: "192.0.2.1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3600, created.Data.TTL)
+	assert.Equal(t, []string{"global"}, created.Data.Regions)
+
+	recordType := "A"
+	listed, err := client.Zones.ListRecords(ctx, account, "example.com", &dnsimple.ZoneRecordListOptions{Type: &recordType})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed.Data) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(listed.Data))
+	}
+	assert.Equal(t, created.Data.ID, listed.Data[0].ID)
+
+	updated, err := client.Zones.UpdateRecord(ctx, account, "example.com", created.Data.ID, dnsimple.ZoneRecordAttributes{Content: "192.0.2.2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "192.0.2.2", updated.Data.Content)
+	assert.Equal(t, "www", updated.Data.Name)
+
+	_, err = client.Zones.DeleteRecord(ctx, account, "example.com", created.Data.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Zones.GetRecord(ctx, account, "example.com", created.Data.ID)
+	var errorResponse *dnsimple.ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("expected an API error, got %v", err)
+	}
+	assert.Equal(t, http.StatusNotFound, errorResponse.HTTPResponse.StatusCode)
+}
+
+func TestMockServer_ListRecordsPagination(t *testing.T) {
+	ctx := context.Background()
+	server, client := test_utils.NewMockClient(t)
+	server.AddDomain("example.com")
+	account := test_utils.MockServerAccount
+
+	for range 5 {
+		_, err := client.Zones.CreateRecord(ctx, account, "example.com", dnsimple.ZoneRecordAttributes{Type: "TXT", Content: "hello"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	options := &dnsimple.ZoneRecordListOptions{ListOptions: dnsimple.ListOptions{PerPage: dnsimple.Int(4), Page: dnsimple.Int(2)}}
+	listed, err := client.Zones.ListRecords(ctx, account, "example.com", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 5 system records (SOA and 4 NS) plus the 5 created records.
+	assert.Len(t, listed.Data, 4)
+	assert.Equal(t, 3, listed.Pagination.TotalPages)
+	assert.Equal(t, 10, listed.Pagination.TotalEntries)
+}
+
+func TestMockServer_DomainLifecycle(t *testing.T) {
+	ctx := context.Background()
+	_, client := test_utils.NewMockClient(t)
+	account := test_utils.MockServerAccount
+
+	created, err := client.Domains.CreateDomain(ctx, account, dnsimple.Domain{Name: "example.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "hosted", created.Data.State)
+
+	zone, err := client.Zones.GetZone(ctx, account, "example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, zone.Data.Active)
+
+	delegation, err := client.Registrar.ChangeDomainDelegation(ctx, account, "example.org", &dnsimple.Delegation{"ns1.example.net"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, dnsimple.Delegation{"ns1.example.net"}, *delegation.Data)
+
+	lock, err := client.Registrar.EnableDomainTransferLock(ctx, account, "example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, lock.Data.Enabled)
+
+	_, err = client.Domains.DeleteDomain(ctx, account, "example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Zones.GetZone(ctx, account, "example.org")
+	assert.Error(t, err)
+}
+
+func TestMockServer_Webhooks(t *testing.T) {
+	ctx := context.Background()
+	_, client := test_utils.NewMockClient(t)
+	account := test_utils.MockServerAccount
+
+	created, err := client.Webhooks.CreateWebhook(ctx, account, dnsimple.Webhook{URL: "https://example.com/webhooks"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhook, err := client.Webhooks.GetWebhook(ctx, account, created.Data.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "https://example.com/webhooks", webhook.Data.URL)
+
+	webhooks, err := client.Webhooks.ListWebhooks(ctx, account, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, webhooks.Data, 1)
+
+	if _, err := client.Webhooks.DeleteWebhook(ctx, account, created.Data.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Webhooks.GetWebhook(ctx, account, created.Data.ID)
+	var errorResponse *dnsimple.ErrorResponse
+	if assert.True(t, errors.As(err, &errorResponse)) {
+		assert.Equal(t, http.StatusNotFound, errorResponse.HTTPResponse.StatusCode)
+	}
+}
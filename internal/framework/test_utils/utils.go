@@ -3,19 +3,73 @@ package test_utils
 import (
 	"context"
 	"os"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/consts"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/provider"
 )
 
+var (
+	sharedMockServer     *MockServer
+	sharedMockServerOnce sync.Once
+)
+
+// MockAPIEnabled reports whether the acceptance tests run against the
+// in-process mock of the DNSimple API instead of a live account.
+func MockAPIEnabled() bool {
+	return os.Getenv("DNSIMPLE_MOCK_API") == "1"
+}
+
+// SharedMockServer returns the mock DNSimple API shared by the acceptance
+// tests of the running package, starting it on first use.
+//
+// The credentials the tests read from the environment are pointed at the mock
+// account, and the domain, contact and certificate fixtures they expect are
+// seeded. DNSIMPLE_DOMAIN is honoured when already set.
+func SharedMockServer() *MockServer {
+	sharedMockServerOnce.Do(func() {
+		sharedMockServer = NewMockServer()
+
+		domainName := os.Getenv("DNSIMPLE_DOMAIN")
+		if domainName == "" {
+			domainName = "example.com"
+		}
+		sharedMockServer.AddDomain(domainName)
+		contact := sharedMockServer.AddContact(dnsimple.Contact{
+			Label:         "Mock",
+			FirstName:     "Jane",
+			LastName:      "Doe",
+			Address1:      "1 Main Street",
+			City:          "Miami",
+			StateProvince: "FL",
+			PostalCode:    "33101",
+			Country:       "US",
+			Phone:         "+1.3055551234",
+			Email:         "jane@example.com",
+		})
+		certificate := sharedMockServer.AddCertificate(domainName, "www")
+
+		_ = os.Setenv("DNSIMPLE_TOKEN", "mock-token")
+		_ = os.Setenv("DNSIMPLE_ACCOUNT", MockServerAccount)
+		_ = os.Setenv("DNSIMPLE_DOMAIN", domainName)
+		_ = os.Setenv("DNSIMPLE_CONTACT_ID", strconv.FormatInt(contact.ID, 10))
+		_ = os.Setenv("DNSIMPLE_CERTIFICATE_NAME", "www")
+		_ = os.Setenv("DNSIMPLE_CERTIFICATE_ID", strconv.FormatInt(certificate.ID, 10))
+	})
+
+	return sharedMockServer
+}
+
 func TestAccProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
-	return map[string]func() (tfprotov6.ProviderServer, error){
-		"dnsimple": providerserver.NewProtocol6WithError(provider.New("test")()),
+	if MockAPIEnabled() {
+		return provider.NewProto6ProviderFactory(provider.WithBaseURL(SharedMockServer().URL))
 	}
+
+	return provider.NewProto6ProviderFactory()
 }
 
 func TestAccPreCheck(t *testing.T) {
@@ -40,6 +94,14 @@ func LoadDNSimpleTestClient() (*dnsimple.Client, string) {
 		return nil, ""
 	}
 
+	if MockAPIEnabled() {
+		dnsimpleClient := dnsimple.NewClient(dnsimple.StaticTokenHTTPClient(context.Background(), "mock-token"))
+		dnsimpleClient.UserAgent = "terraform-provider-dnsimple/test"
+		dnsimpleClient.BaseURL = SharedMockServer().URL
+
+		return dnsimpleClient, MockServerAccount
+	}
+
 	token := os.Getenv("DNSIMPLE_TOKEN")
 	account := os.Getenv("DNSIMPLE_ACCOUNT")
 
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// TXTStringMaxLength is the maximum length of a single character string in a
+// TXT record, as per RFC 1035.
+const TXTStringMaxLength = 255
+
+// SplitTXTStrings splits TXT record content made of quoted strings, such as
+// `"first" "second"`, into the unescaped strings. It reports false when the
+// content is not made of quoted strings only.
+func SplitTXTStrings(content string) ([]string, bool) {
+	if !strings.HasPrefix(content, `"`) {
+		return nil, false
+	}
+
+	var (
+		strs    []string
+		current strings.Builder
+		inQuote bool
+	)
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case inQuote && c == '\\' && i+1 < len(content):
+			i++
+			current.WriteByte(content[i])
+		case inQuote && c == '"':
+			inQuote = false
+			strs = append(strs, current.String())
+			current.Reset()
+		case inQuote:
+			current.WriteByte(c)
+		case c == '"':
+			inQuote = true
+		case c == ' ' || c == '\t':
+		default:
+			return nil, false
+		}
+	}
+
+	if inQuote {
+		return nil, false
+	}
+
+	return strs, true
+}
+
+// JoinTXTStrings serializes strings into TXT record content, quoting and
+// escaping each of them. Strings longer than TXTStringMaxLength bytes are
+// split on character boundaries into several character strings, which
+// resolvers join back.
+func JoinTXTStrings(strs []string) string {
+	quoted := make([]string, 0, len(strs))
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	for _, str := range strs {
+		for {
+			chunk := str
+			if len(chunk) > TXTStringMaxLength {
+				cut := TXTStringMaxLength
+				for cut > 0 && !utf8.RuneStart(chunk[cut]) {
+					cut--
+				}
+				chunk = chunk[:cut]
+			}
+			quoted = append(quoted, `"`+escaper.Replace(chunk)+`"`)
+
+			str = str[len(chunk):]
+			if str == "" {
+				break
+			}
+		}
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// ChunkTXTContent splits unquoted TXT record content longer than
+// TXTStringMaxLength into quoted character strings. Content that is already
+// quoted, or short enough, is returned as is.
+func ChunkTXTContent(content string) string {
+	if len(content) <= TXTStringMaxLength || strings.HasPrefix(content, `"`) {
+		return content
+	}
+
+	return JoinTXTStrings([]string{content})
+}
@@ -49,6 +49,12 @@ func HasUnicodeChars(s string) bool {
 }
 
 func RetryWithTimeout(ctx context.Context, fn func() (error, bool), timeout time.Duration, delay time.Duration) error {
+	return RetryWithBackoff(ctx, fn, timeout, delay, delay)
+}
+
+// RetryWithBackoff works like RetryWithTimeout, but doubles the delay after
+// each failed attempt, up to maxDelay.
+func RetryWithBackoff(ctx context.Context, fn func() (error, bool), timeout time.Duration, delay time.Duration, maxDelay time.Duration) error {
 	deadline := time.Now().Add(timeout)
 	for {
 		err, suspend := fn()
@@ -68,8 +74,9 @@ func RetryWithTimeout(ctx context.Context, fn func() (error, bool), timeout time
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-time.After(delay):
-			continue
 		}
+
+		delay = min(delay*2, maxDelay)
 	}
 }
 
@@ -1,9 +1,13 @@
 package utils_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
 	"github.com/stretchr/testify/assert"
@@ -92,3 +96,92 @@ func TestIsDomainNotRegisteredOrExpiredError(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryWithBackoff(t *testing.T) {
+	var attempts []time.Time
+	err := utils.RetryWithBackoff(context.Background(), func() (error, bool) {
+		attempts = append(attempts, time.Now())
+		if len(attempts) < 4 {
+			return errors.New("not yet"), false
+		}
+		return nil, false
+	}, time.Minute, 10*time.Millisecond, 25*time.Millisecond)
+
+	assert.NoError(t, err)
+	assert.Len(t, attempts, 4)
+	// The delay doubles after each attempt, up to the maximum delay.
+	assert.GreaterOrEqual(t, attempts[1].Sub(attempts[0]), 10*time.Millisecond)
+	assert.GreaterOrEqual(t, attempts[2].Sub(attempts[1]), 20*time.Millisecond)
+	assert.GreaterOrEqual(t, attempts[3].Sub(attempts[2]), 25*time.Millisecond)
+	assert.Less(t, attempts[3].Sub(attempts[2]), 40*time.Millisecond)
+}
+
+func TestRetryWithBackoff_Timeout(t *testing.T) {
+	attempts := 0
+	err := utils.RetryWithBackoff(context.Background(), func() (error, bool) {
+		attempts++
+		return errors.New("not yet"), false
+	}, 30*time.Millisecond, 10*time.Millisecond, 10*time.Millisecond)
+
+	// The last error is returned once the timeout is reached.
+	assert.EqualError(t, err, "not yet")
+	assert.Greater(t, attempts, 1)
+}
+
+func TestRetryWithBackoff_Suspend(t *testing.T) {
+	attempts := 0
+	err := utils.RetryWithBackoff(context.Background(), func() (error, bool) {
+		attempts++
+		return errors.New("gone"), true
+	}, time.Minute, 10*time.Millisecond, 10*time.Millisecond)
+
+	assert.EqualError(t, err, "gone")
+	assert.Equal(t, 1, attempts)
+}
+
+func TestJoinTXTStrings(t *testing.T) {
+	long := strings.Repeat("a", 300)
+
+	assert.Equal(t, `"v=spf1 -all"`, utils.JoinTXTStrings([]string{"v=spf1 -all"}))
+	assert.Equal(t, `"first" "say \"hi\""`, utils.JoinTXTStrings([]string{"first", `say "hi"`}))
+	assert.Equal(t, `"`+strings.Repeat("a", 255)+`" "`+strings.Repeat("a", 45)+`"`, utils.JoinTXTStrings([]string{long}))
+}
+
+func TestJoinTXTStrings_MultiByte(t *testing.T) {
+	// The 255th byte falls within the two bytes of "é".
+	long := strings.Repeat("a", 254) + "é" + strings.Repeat("b", 10)
+
+	strs, ok := utils.SplitTXTStrings(utils.JoinTXTStrings([]string{long}))
+	assert.True(t, ok)
+	assert.Equal(t, []string{strings.Repeat("a", 254), "é" + strings.Repeat("b", 10)}, strs)
+	for _, str := range strs {
+		assert.True(t, utf8.ValidString(str))
+		assert.LessOrEqual(t, len(str), utils.TXTStringMaxLength)
+	}
+}
+
+func TestSplitTXTStrings(t *testing.T) {
+	strs, ok := utils.SplitTXTStrings(`"first" "say \"hi\""`)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"first", `say "hi"`}, strs)
+
+	_, ok = utils.SplitTXTStrings("v=spf1 -all")
+	assert.False(t, ok)
+
+	_, ok = utils.SplitTXTStrings(`"unterminated`)
+	assert.False(t, ok)
+
+	_, ok = utils.SplitTXTStrings(`"quoted" unquoted`)
+	assert.False(t, ok)
+}
+
+func TestChunkTXTContent(t *testing.T) {
+	long := strings.Repeat("k", 400)
+
+	assert.Equal(t, "v=spf1 -all", utils.ChunkTXTContent("v=spf1 -all"))
+	assert.Equal(t, `"`+long+`"`, utils.ChunkTXTContent(`"`+long+`"`))
+
+	strs, ok := utils.SplitTXTStrings(utils.ChunkTXTContent(long))
+	assert.True(t, ok)
+	assert.Equal(t, []string{long[:255], long[255:]}, strs)
+}
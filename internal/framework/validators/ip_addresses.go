@@ -0,0 +1,65 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Set = PublicIPAddresses{}
+
+// PublicIPAddresses validates that every element of a set of strings is a
+// public IPv4 or IPv6 address, such as the address of a server DNSimple has
+// to reach.
+type PublicIPAddresses struct{}
+
+func (v PublicIPAddresses) Description(ctx context.Context) string {
+	return "each element must be a public IPv4 or IPv6 address"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior, suitable for a practitioner to understand its impact.
+func (v PublicIPAddresses) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate runs the main validation logic of the validator, reading
+// configuration data out of `req` and updating `resp` with diagnostics.
+func (v PublicIPAddresses) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		address, ok := element.(types.String)
+		if !ok || address.IsUnknown() || address.IsNull() {
+			continue
+		}
+
+		if err := validatePublicIPAddress(address.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid IP address",
+				err.Error(),
+			)
+		}
+	}
+}
+
+func validatePublicIPAddress(value string) error {
+	addr, err := netip.ParseAddr(value)
+	if err != nil || addr.Zone() != "" {
+		return fmt.Errorf("%q is not an IPv4 or IPv6 address", value)
+	}
+
+	switch {
+	case addr.IsUnspecified(), addr.IsLoopback(), addr.IsMulticast(), addr.IsPrivate(),
+		addr.IsLinkLocalUnicast(), addr.IsLinkLocalMulticast(), addr.IsInterfaceLocalMulticast():
+		return fmt.Errorf("%q is not a public address, DNSimple could not reach it", value)
+	}
+
+	return nil
+}
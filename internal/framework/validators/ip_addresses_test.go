@@ -0,0 +1,69 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicIPAddresses_ValidateSet(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		value      types.Set
+		errorCount int
+	}
+
+	addresses := func(values ...attr.Value) types.Set {
+		return types.SetValueMust(types.StringType, values)
+	}
+
+	tests := map[string]testCase{
+		"public addresses": {
+			value: addresses(types.StringValue("192.0.2.10"), types.StringValue("2001:db8::53"), types.StringValue("8.8.8.8")),
+		},
+		"null set": {
+			value: types.SetNull(types.StringType),
+		},
+		"unknown element": {
+			value: addresses(types.StringValue("8.8.8.8"), types.StringUnknown()),
+		},
+		"host name": {
+			value:      addresses(types.StringValue("ns1.example.com")),
+			errorCount: 1,
+		},
+		"address with a zone": {
+			value:      addresses(types.StringValue("fe80::1%eth0")),
+			errorCount: 1,
+		},
+		"private and loopback addresses": {
+			value:      addresses(types.StringValue("10.0.0.53"), types.StringValue("127.0.0.1"), types.StringValue("8.8.4.4")),
+			errorCount: 2,
+		},
+		"unspecified address": {
+			value:      addresses(types.StringValue("::")),
+			errorCount: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.SetRequest{
+				Path:        path.Root("primary_servers"),
+				ConfigValue: test.value,
+			}
+			response := validator.SetResponse{}
+
+			PublicIPAddresses{}.ValidateSet(context.Background(), request, &response)
+
+			assert.Equal(t, test.errorCount, response.Diagnostics.ErrorsCount(), "%s", response.Diagnostics)
+		})
+	}
+}
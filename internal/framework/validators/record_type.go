@@ -3,6 +3,7 @@ package validators
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -12,10 +13,36 @@ import (
 
 var _ validator.String = RecordType{}
 
+// SupportedRecordTypes are the record types DNSimple supports.
+var SupportedRecordTypes = []string{
+	"A",
+	"AAAA",
+	"ALIAS",
+	"CAA",
+	"CNAME",
+	"DNSKEY",
+	"DS",
+	"HINFO",
+	"HTTPS",
+	"MX",
+	"NAPTR",
+	"NS",
+	"POOL",
+	"PTR",
+	"SOA",
+	"SPF",
+	"SRV",
+	"SSHFP",
+	"SVCB",
+	"TLSA",
+	"TXT",
+	"URL",
+}
+
 type RecordType struct{}
 
 func (v RecordType) Description(ctx context.Context) string {
-	return "record type must be specified in UPPERCASE"
+	return "record type must be specified in UPPERCASE and be supported by DNSimple"
 }
 
 // MarkdownDescription returns a markdown formatted description of the
@@ -47,4 +74,13 @@ func (v RecordType) ValidateString(ctx context.Context, req validator.StringRequ
 		)
 		return
 	}
+
+	if recordTypeValue != "" && !slices.Contains(SupportedRecordTypes, recordTypeValue) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported record type",
+			fmt.Sprintf("Record type %q is not supported by DNSimple. Supported types are: %s.", recordTypeValue, strings.Join(SupportedRecordTypes, ", ")),
+		)
+		return
+	}
 }
@@ -145,8 +145,24 @@ func TestRecordType_Description(t *testing.T) {
 	validator := RecordType{}
 
 	description := validator.Description(ctx)
-	assert.Equal(t, "record type must be specified in UPPERCASE", description)
+	assert.Equal(t, "record type must be specified in UPPERCASE and be supported by DNSimple", description)
 
 	markdownDescription := validator.MarkdownDescription(ctx)
 	assert.Equal(t, description, markdownDescription)
 }
+
+func TestRecordType_ValidateString_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	response := validator.StringResponse{}
+	RecordType{}.ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("type"),
+		ConfigValue: types.StringValue("LOC"),
+	}, &response)
+
+	if !response.Diagnostics.HasError() {
+		t.Fatal("expected error, got no error")
+	}
+	assert.Equal(t, "Unsupported record type", response.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, response.Diagnostics.Errors()[0].Detail(), "LOC")
+}
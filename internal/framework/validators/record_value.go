@@ -0,0 +1,461 @@
+package validators
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+var (
+	_ validator.String = RecordValue{}
+	_ validator.Int64  = RecordPriority{}
+)
+
+// PriorityRecordTypes are the record types that carry a priority.
+var PriorityRecordTypes = []string{"MX", "SRV"}
+
+var (
+	hostnameLabelRegexp = regexp.MustCompile(`^[A-Za-z0-9_]([A-Za-z0-9_-]*[A-Za-z0-9_])?$`)
+	caaRegexp           = regexp.MustCompile(`^(\d+)\s+([A-Za-z0-9]+)\s+"(.*)"$`)
+)
+
+// recordValueValidators check the value of a record against the format its
+// type expects, as the DNSimple API stores it.
+var recordValueValidators = map[string]func(value string) error{
+	"A":     validateARecordValue,
+	"AAAA":  validateAAAARecordValue,
+	"ALIAS": validateHostnameRecordValue,
+	"CAA":   validateCAARecordValue,
+	"CNAME": validateHostnameRecordValue,
+	"HINFO": validateHINFORecordValue,
+	"MX":    validateMXRecordValue,
+	"NAPTR": validateNAPTRRecordValue,
+	"NS":    validateHostnameRecordValue,
+	"POOL":  validateHostnameRecordValue,
+	"PTR":   validateHostnameRecordValue,
+	"SRV":   validateSRVRecordValue,
+	"SSHFP": validateSSHFPRecordValue,
+	"TLSA":  validateTLSARecordValue,
+	"TXT":   validateTXTRecordValue,
+	"SPF":   validateTXTRecordValue,
+	"URL":   validateURLRecordValue,
+}
+
+// ValidateRecordValue checks the value of a record of the given type. Types
+// without a known format are accepted as is.
+func ValidateRecordValue(recordType string, value string) error {
+	validate, ok := recordValueValidators[recordType]
+	if !ok {
+		return nil
+	}
+
+	return validate(value)
+}
+
+// ValidateRecordPriority checks the priority of a record of the given type.
+func ValidateRecordPriority(recordType string, priority int64) error {
+	if priority < 0 || priority > 65535 {
+		return fmt.Errorf("priority must be between 0 and 65535, got %d", priority)
+	}
+
+	if priority != 0 && !slices.Contains(PriorityRecordTypes, recordType) {
+		return fmt.Errorf("priority is only used by %s records, but got %d for a %s record", strings.Join(PriorityRecordTypes, " and "), priority, recordType)
+	}
+
+	return nil
+}
+
+// RecordValue validates the value of a record against the type set on the
+// sibling type attribute.
+type RecordValue struct{}
+
+func (v RecordValue) Description(ctx context.Context) string {
+	return "record value must be valid for the record type"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior, suitable for a practitioner to understand its impact.
+func (v RecordValue) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate runs the main validation logic of the validator, reading
+// configuration data out of `req` and updating `resp` with diagnostics.
+func (v RecordValue) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	var value types.String
+	resp.Diagnostics.Append(tfsdk.ValueAs(ctx, req.ConfigValue, &value)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if value.IsUnknown() || value.IsNull() {
+		return
+	}
+
+	var recordType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("type"), &recordType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if recordType.IsUnknown() || recordType.IsNull() {
+		return
+	}
+
+	if err := ValidateRecordValue(recordType.ValueString(), value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			fmt.Sprintf("Invalid %s record value", recordType.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+}
+
+// RecordPriority validates the priority of a record against the type set on
+// the sibling type attribute.
+type RecordPriority struct{}
+
+func (v RecordPriority) Description(ctx context.Context) string {
+	return "record priority must be between 0 and 65535, and is only used by MX and SRV records"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior, suitable for a practitioner to understand its impact.
+func (v RecordPriority) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate runs the main validation logic of the validator, reading
+// configuration data out of `req` and updating `resp` with diagnostics.
+func (v RecordPriority) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	var recordType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("type"), &recordType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if recordType.IsUnknown() || recordType.IsNull() {
+		return
+	}
+
+	if err := ValidateRecordPriority(recordType.ValueString(), req.ConfigValue.ValueInt64()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid record priority",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func validateARecordValue(value string) error {
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() == nil || strings.Contains(value, ":") {
+		return fmt.Errorf("an A record value must be an IPv4 address, got %q", value)
+	}
+
+	return nil
+}
+
+func validateAAAARecordValue(value string) error {
+	ip := net.ParseIP(value)
+	if ip == nil || !strings.Contains(value, ":") {
+		return fmt.Errorf("an AAAA record value must be an IPv6 address, got %q", value)
+	}
+
+	return nil
+}
+
+func validateHostnameRecordValue(value string) error {
+	return validateHostname(value)
+}
+
+func validateMXRecordValue(value string) error {
+	// A null MX record, as of RFC 7505, tells that the domain accepts no mail.
+	if value == "." {
+		return nil
+	}
+
+	if fields := strings.Fields(value); len(fields) == 2 {
+		if _, err := strconv.Atoi(fields[0]); err == nil {
+			return fmt.Errorf("an MX record value must be the mail server host name, got %q. Set the preference %s with the priority attribute instead", value, fields[0])
+		}
+	}
+
+	return validateHostname(value)
+}
+
+func validateSRVRecordValue(value string) error {
+	fields := strings.Fields(value)
+	if len(fields) == 4 {
+		return fmt.Errorf("an SRV record value must be \"weight port target\", got %q. Set the priority %s with the priority attribute instead", value, fields[0])
+	}
+	if len(fields) != 3 {
+		return fmt.Errorf("an SRV record value must be \"weight port target\", got %q", value)
+	}
+
+	if err := validateUint(fields[0], "weight", 65535); err != nil {
+		return err
+	}
+	if err := validateUint(fields[1], "port", 65535); err != nil {
+		return err
+	}
+	if fields[2] == "." {
+		return nil
+	}
+
+	return validateHostname(fields[2])
+}
+
+func validateCAARecordValue(value string) error {
+	matches := caaRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return fmt.Errorf("a CAA record value must be `flags tag \"value\"`, such as `0 issue \"letsencrypt.org\"`, got %q", value)
+	}
+
+	if err := validateUint(matches[1], "flags", 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateTXTRecordValue(value string) error {
+	if value == "" {
+		return fmt.Errorf("a TXT record value must not be empty")
+	}
+
+	// Unquoted values are sent as a single string, quoted values must be a
+	// well formed sequence of strings of up to 255 characters each.
+	if !strings.HasPrefix(value, `"`) {
+		return nil
+	}
+
+	strs, err := splitRecordValueFields(value)
+	if err != nil {
+		return err
+	}
+	for _, str := range strs {
+		if !str.quoted {
+			return fmt.Errorf("a quoted TXT record value must only contain quoted strings, got %q", str.value)
+		}
+		if len(str.value) > 255 {
+			return fmt.Errorf("each string of a TXT record value must be at most 255 characters long, got %d", len(str.value))
+		}
+	}
+
+	return nil
+}
+
+func validateSSHFPRecordValue(value string) error {
+	fields := strings.Fields(value)
+	if len(fields) != 3 {
+		return fmt.Errorf("an SSHFP record value must be \"algorithm type fingerprint\", got %q", value)
+	}
+
+	algorithm, err := strconv.Atoi(fields[0])
+	if err != nil || !slices.Contains([]int{1, 2, 3, 4, 6}, algorithm) {
+		return fmt.Errorf("SSHFP algorithm must be one of 1 (RSA), 2 (DSA), 3 (ECDSA), 4 (Ed25519) or 6 (Ed448), got %q", fields[0])
+	}
+
+	lengths := map[string]int{"1": 40, "2": 64}
+	length, ok := lengths[fields[1]]
+	if !ok {
+		return fmt.Errorf("SSHFP fingerprint type must be 1 (SHA-1) or 2 (SHA-256), got %q", fields[1])
+	}
+
+	return validateHex(fields[2], "SSHFP fingerprint", length)
+}
+
+func validateTLSARecordValue(value string) error {
+	fields := strings.Fields(value)
+	if len(fields) != 4 {
+		return fmt.Errorf("a TLSA record value must be \"usage selector matching-type data\", got %q", value)
+	}
+
+	if err := validateUint(fields[0], "TLSA certificate usage", 3); err != nil {
+		return err
+	}
+	if err := validateUint(fields[1], "TLSA selector", 1); err != nil {
+		return err
+	}
+	if err := validateUint(fields[2], "TLSA matching type", 2); err != nil {
+		return err
+	}
+
+	lengths := map[string]int{"0": 0, "1": 64, "2": 128}
+	return validateHex(fields[3], "TLSA certificate association data", lengths[fields[2]])
+}
+
+func validateNAPTRRecordValue(value string) error {
+	fields, err := splitRecordValueFields(value)
+	if err != nil {
+		return err
+	}
+	if len(fields) != 6 {
+		return fmt.Errorf("a NAPTR record value must be `order preference \"flags\" \"service\" \"regexp\" replacement`, got %q", value)
+	}
+
+	if err := validateUint(fields[0].value, "NAPTR order", 65535); err != nil {
+		return err
+	}
+	if err := validateUint(fields[1].value, "NAPTR preference", 65535); err != nil {
+		return err
+	}
+	for _, field := range fields[2:5] {
+		if !field.quoted {
+			return fmt.Errorf("NAPTR flags, service and regexp must be quoted strings, got %q", field.value)
+		}
+	}
+	if fields[5].value == "." {
+		return nil
+	}
+
+	return validateHostname(fields[5].value)
+}
+
+func validateHINFORecordValue(value string) error {
+	fields, err := splitRecordValueFields(value)
+	if err != nil {
+		return err
+	}
+	if len(fields) != 2 {
+		return fmt.Errorf("an HINFO record value must be `\"cpu\" \"os\"`, got %q", value)
+	}
+
+	return nil
+}
+
+func validateURLRecordValue(value string) error {
+	target := value
+	if !strings.Contains(target, "://") {
+		target = "http://" + target
+	}
+
+	parsed, err := url.Parse(target)
+	if err != nil || parsed.Host == "" || strings.ContainsAny(value, " \t") {
+		return fmt.Errorf("a URL record value must be the URL to redirect to, got %q", value)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("a URL record value must be an http or https URL, got %q", value)
+	}
+
+	return nil
+}
+
+// validateHostname checks a fully qualified host name, with or without the
+// trailing dot.
+func validateHostname(value string) error {
+	if utils.HasUnicodeChars(value) {
+		return fmt.Errorf("host name %q should not contain unicode characters, please use punycode", value)
+	}
+
+	name := strings.TrimSuffix(value, ".")
+	if name == "" || len(name) > 253 {
+		return fmt.Errorf("%q is not a valid host name", value)
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if len(label) > 63 || !hostnameLabelRegexp.MatchString(label) {
+			return fmt.Errorf("%q is not a valid host name", value)
+		}
+	}
+
+	return nil
+}
+
+func validateUint(value string, field string, maximum int) error {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 || number > maximum {
+		return fmt.Errorf("%s must be a number between 0 and %d, got %q", field, maximum, value)
+	}
+
+	return nil
+}
+
+// validateHex checks hex encoded data, of the given length unless it is 0.
+func validateHex(value string, field string, length int) error {
+	if _, err := hex.DecodeString(value); err != nil {
+		return fmt.Errorf("%s must be hex encoded, got %q", field, value)
+	}
+	if length > 0 && len(value) != length {
+		return fmt.Errorf("%s must be %d hex characters long, got %d", field, length, len(value))
+	}
+
+	return nil
+}
+
+// recordValueField is a field of a record value, either a bare word or a
+// quoted string.
+type recordValueField struct {
+	value  string
+	quoted bool
+}
+
+// splitRecordValueFields splits a record value on blanks, keeping quoted
+// strings, which may contain blanks and escaped quotes, as single fields.
+func splitRecordValueFields(value string) ([]recordValueField, error) {
+	var (
+		fields  []recordValueField
+		current strings.Builder
+		inField bool
+		quoted  bool
+		inQuote bool
+	)
+
+	flush := func() {
+		if inField {
+			fields = append(fields, recordValueField{value: current.String(), quoted: quoted})
+		}
+		current.Reset()
+		inField = false
+		quoted = false
+	}
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case inQuote && c == '\\' && i+1 < len(value):
+			i++
+			current.WriteByte(value[i])
+		case inQuote && c == '"':
+			inQuote = false
+			flush()
+		case inQuote:
+			current.WriteByte(c)
+		case c == '"':
+			flush()
+			inField = true
+			quoted = true
+			inQuote = true
+		case c == ' ' || c == '\t':
+			flush()
+		default:
+			inField = true
+			current.WriteByte(c)
+		}
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("unterminated quoted string in %q", value)
+	}
+	flush()
+
+	return fields, nil
+}
@@ -0,0 +1,178 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRecordValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		recordType  string
+		value       string
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"A valid":                  {recordType: "A", value: "192.0.2.1"},
+		"A IPv6":                   {recordType: "A", value: "2001:db8::1", expectError: true},
+		"A IPv4-mapped IPv6":       {recordType: "A", value: "::ffff:192.0.2.1", expectError: true},
+		"A host name":              {recordType: "A", value: "example.com", expectError: true},
+		"AAAA valid":               {recordType: "AAAA", value: "2001:db8::1"},
+		"AAAA IPv4":                {recordType: "AAAA", value: "192.0.2.1", expectError: true},
+		"CAA valid":                {recordType: "CAA", value: `0 issue "letsencrypt.org"`},
+		"CAA unquoted":             {recordType: "CAA", value: "0 issue letsencrypt.org", expectError: true},
+		"CAA flags out of range":   {recordType: "CAA", value: `256 issue "letsencrypt.org"`, expectError: true},
+		"MX valid":                 {recordType: "MX", value: "mail.example.com"},
+		"MX trailing dot":          {recordType: "MX", value: "mail.example.com."},
+		"MX null":                  {recordType: "MX", value: "."},
+		"MX with preference":       {recordType: "MX", value: "10 mail.example.com", expectError: true},
+		"SRV valid":                {recordType: "SRV", value: "60 5060 sip.example.com"},
+		"SRV with priority":        {recordType: "SRV", value: "10 60 5060 sip.example.com", expectError: true},
+		"SRV port out of range":    {recordType: "SRV", value: "60 70000 sip.example.com", expectError: true},
+		"TXT unquoted":             {recordType: "TXT", value: "v=spf1 -all"},
+		"TXT quoted strings":       {recordType: "TXT", value: `"first" "second \"part\""`},
+		"TXT empty":                {recordType: "TXT", value: "", expectError: true},
+		"TXT unterminated":         {recordType: "TXT", value: `"unterminated`, expectError: true},
+		"TXT string too long":      {recordType: "TXT", value: `"` + strings.Repeat("a", 256) + `"`, expectError: true},
+		"SSHFP valid":              {recordType: "SSHFP", value: "4 2 " + strings.Repeat("ab", 32)},
+		"SSHFP wrong length":       {recordType: "SSHFP", value: "4 2 " + strings.Repeat("ab", 20), expectError: true},
+		"SSHFP bad algorithm":      {recordType: "SSHFP", value: "9 1 " + strings.Repeat("ab", 20), expectError: true},
+		"TLSA valid":               {recordType: "TLSA", value: "3 1 1 " + strings.Repeat("0f", 32)},
+		"TLSA full certificate":    {recordType: "TLSA", value: "3 0 0 308201"},
+		"TLSA bad usage":           {recordType: "TLSA", value: "4 1 1 " + strings.Repeat("0f", 32), expectError: true},
+		"TLSA not hex":             {recordType: "TLSA", value: "3 1 1 xyz", expectError: true},
+		"NAPTR valid":              {recordType: "NAPTR", value: `100 10 "S" "SIP+D2U" "!^.*$!sip:info@example.com!" _sip._udp.example.com.`},
+		"NAPTR empty flags":        {recordType: "NAPTR", value: `100 10 "" "" "" .`},
+		"NAPTR missing field":      {recordType: "NAPTR", value: `100 10 "S" "SIP+D2U" _sip._udp.example.com.`, expectError: true},
+		"HINFO valid":              {recordType: "HINFO", value: `"INTEL-386" "Linux"`},
+		"HINFO single field":       {recordType: "HINFO", value: `"INTEL-386"`, expectError: true},
+		"ALIAS valid":              {recordType: "ALIAS", value: "example.herokuapp.com"},
+		"ALIAS IP address":         {recordType: "ALIAS", value: "192.0.2.1:80", expectError: true},
+		"ALIAS unicode":            {recordType: "ALIAS", value: "exämple.com", expectError: true},
+		"CNAME label too long":     {recordType: "CNAME", value: strings.Repeat("a", 64) + ".example.com", expectError: true},
+		"URL valid":                {recordType: "URL", value: "https://example.com/path"},
+		"URL without scheme":       {recordType: "URL", value: "example.com"},
+		"URL unsupported scheme":   {recordType: "URL", value: "ftp://example.com", expectError: true},
+		"POOL valid":               {recordType: "POOL", value: "pool-1.example.com"},
+		"POOL invalid":             {recordType: "POOL", value: "not a host", expectError: true},
+		"unchecked type":           {recordType: "DS", value: "anything goes"},
+		"unsupported type ignored": {recordType: "LOC", value: "anything goes"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateRecordValue(test.recordType, test.value)
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRecordPriority(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateRecordPriority("MX", 10))
+	assert.NoError(t, ValidateRecordPriority("SRV", 65535))
+	assert.NoError(t, ValidateRecordPriority("A", 0))
+	assert.Error(t, ValidateRecordPriority("MX", -1))
+	assert.Error(t, ValidateRecordPriority("SRV", 65536))
+	assert.Error(t, ValidateRecordPriority("A", 10))
+}
+
+// recordTestConfig returns a configuration holding a record of the given type,
+// value and priority.
+func recordTestConfig(t *testing.T, recordType string, value string, priority int64) tfsdk.Config {
+	t.Helper()
+
+	recordSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type":     schema.StringAttribute{Required: true},
+			"value":    schema.StringAttribute{Required: true},
+			"priority": schema.Int64Attribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":     tftypes.String,
+		"value":    tftypes.String,
+		"priority": tftypes.Number,
+	}}
+
+	return tfsdk.Config{
+		Schema: recordSchema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"type":     tftypes.NewValue(tftypes.String, recordType),
+			"value":    tftypes.NewValue(tftypes.String, value),
+			"priority": tftypes.NewValue(tftypes.Number, priority),
+		}),
+	}
+}
+
+func TestRecordValue_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	response := validator.StringResponse{}
+	RecordValue{}.ValidateString(ctx, validator.StringRequest{
+		Path:        path.Root("value"),
+		ConfigValue: types.StringValue("2001:db8::1"),
+		Config:      recordTestConfig(t, "A", "2001:db8::1", 0),
+	}, &response)
+
+	if !response.Diagnostics.HasError() {
+		t.Fatal("expected error, got no error")
+	}
+	assert.Equal(t, "Invalid A record value", response.Diagnostics.Errors()[0].Summary())
+
+	response = validator.StringResponse{}
+	RecordValue{}.ValidateString(ctx, validator.StringRequest{
+		Path:        path.Root("value"),
+		ConfigValue: types.StringValue("192.0.2.1"),
+		Config:      recordTestConfig(t, "A", "192.0.2.1", 0),
+	}, &response)
+
+	assert.False(t, response.Diagnostics.HasError(), "got unexpected error: %s", response.Diagnostics)
+}
+
+func TestRecordPriority_ValidateInt64(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	response := validator.Int64Response{}
+	RecordPriority{}.ValidateInt64(ctx, validator.Int64Request{
+		Path:        path.Root("priority"),
+		ConfigValue: types.Int64Value(10),
+		Config:      recordTestConfig(t, "TXT", "text", 10),
+	}, &response)
+
+	if !response.Diagnostics.HasError() {
+		t.Fatal("expected error, got no error")
+	}
+	assert.Equal(t, "Invalid record priority", response.Diagnostics.Errors()[0].Summary())
+
+	response = validator.Int64Response{}
+	RecordPriority{}.ValidateInt64(ctx, validator.Int64Request{
+		Path:        path.Root("priority"),
+		ConfigValue: types.Int64Value(10),
+		Config:      recordTestConfig(t, "MX", "mail.example.com", 10),
+	}, &response)
+
+	assert.False(t, response.Diagnostics.HasError(), "got unexpected error: %s", response.Diagnostics)
+}
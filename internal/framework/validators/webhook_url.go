@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = WebhookURL{}
+
+// WebhookURL validates that a string is an absolute http or https URL, such
+// as the URL DNSimple delivers webhook events to.
+type WebhookURL struct{}
+
+func (v WebhookURL) Description(ctx context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior, suitable for a practitioner to understand its impact.
+func (v WebhookURL) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate runs the main validation logic of the validator, reading
+// configuration data out of `req` and updating `resp` with diagnostics.
+func (v WebhookURL) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" || parsed.Hostname() == "" || strings.ContainsAny(value, " \t\r\n") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid webhook URL",
+			fmt.Sprintf("The webhook URL must be an absolute URL, got %q", value),
+		)
+		return
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid webhook URL",
+			fmt.Sprintf("The webhook URL must be an http or https URL, got %q", value),
+		)
+	}
+}
@@ -0,0 +1,71 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookURL_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		value      types.String
+		errorCount int
+	}
+
+	tests := map[string]testCase{
+		"https URL": {
+			value: types.StringValue("https://example.com/webhooks/dnsimple"),
+		},
+		"http URL with a port and query": {
+			value: types.StringValue("http://example.com:8080/hook?token=abc"),
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"empty": {
+			value:      types.StringValue(""),
+			errorCount: 1,
+		},
+		"missing scheme": {
+			value:      types.StringValue("example.com/webhooks"),
+			errorCount: 1,
+		},
+		"unsupported scheme": {
+			value:      types.StringValue("ftp://example.com/webhooks"),
+			errorCount: 1,
+		},
+		"missing host": {
+			value:      types.StringValue("https:///webhooks"),
+			errorCount: 1,
+		},
+		"whitespace": {
+			value:      types.StringValue("https://example.com/web hooks"),
+			errorCount: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:        path.Root("url"),
+				ConfigValue: test.value,
+			}
+			response := validator.StringResponse{}
+
+			WebhookURL{}.ValidateString(context.Background(), request, &response)
+
+			assert.Equal(t, test.errorCount, response.Diagnostics.ErrorsCount(), "%s", response.Diagnostics)
+		})
+	}
+}